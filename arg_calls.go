@@ -0,0 +1,179 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+)
+
+// argCall is a func arg to be called when the call is made (see Call.CallArg)
+type argCall struct {
+	index   int
+	argSets [][]any
+	async   bool
+}
+
+// ArgCallResult is the result of calling a func arg of a call (see Call.CallArg and Call.ArgCalls)
+type ArgCallResult struct {
+	// Index is the index of the func arg that was called
+	Index int
+	// Args are the args the func was called with
+	Args []any
+	// Returns are the values returned by the func
+	Returns []any
+}
+
+// CallArg calls the func arg at the specified index (with the specified args) when the call is made - e.g. for methods
+// that take a callback, such as Walk(fn func(path string) error), e.g.
+//
+//	myMock.OnMethod(myMock.Walk).CallArg(0, "/a").Return(nil)
+//
+// The func is called synchronously (before the call returns) - use CallArgAsync to call it in a goroutine.
+// The values returned by the func can be obtained using ArgCalls
+func (c *Call) CallArg(index int, args ...any) *Call {
+	return c.addArgCall(index, false, [][]any{args})
+}
+
+// CallArgSequence calls the func arg at the specified index with each of the sets of args (in turn) when the call is made, e.g.
+//
+//	myMock.OnMethod(myMock.Walk).CallArgSequence(0, []any{"/a"}, []any{"/b"}).Return(nil)
+func (c *Call) CallArgSequence(index int, argSets ...[]any) *Call {
+	return c.addArgCall(index, false, argSets)
+}
+
+// CallArgAsync is the same as CallArg - except that the func is called in a goroutine (use WaitArgCalls to wait for it to complete)
+func (c *Call) CallArgAsync(index int, args ...any) *Call {
+	return c.addArgCall(index, true, [][]any{args})
+}
+
+// CallArgSequenceAsync is the same as CallArgSequence - except that the func is called in a goroutine (use WaitArgCalls to wait
+// for it to complete)
+func (c *Call) CallArgSequenceAsync(index int, argSets ...[]any) *Call {
+	return c.addArgCall(index, true, argSets)
+}
+
+// ArgCalls returns the results of func args called so far (see CallArg)
+func (c *Call) ArgCalls() []ArgCallResult {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	return append(make([]ArgCallResult, 0, len(c.argCallResults)), c.argCallResults...)
+}
+
+// WaitArgCalls waits for all func args being called in goroutines to complete (see CallArgAsync)
+func (c *Call) WaitArgCalls() *Call {
+	c.argCallsWg.Wait()
+	return c
+}
+
+func (c *Call) addArgCall(index int, async bool, argSets [][]any) *Call {
+	for _, args := range argSets {
+		c.validateArgCall(index, args)
+	}
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.argCalls = append(c.argCalls, argCall{index: index, argSets: argSets, async: async})
+	return c
+}
+
+// validateArgCall checks the CallArg index and args against the mocked method (and fails if the func arg cannot be called with the args)
+func (c *Call) validateArgCall(index int, args []any) {
+	if index < 0 {
+		c.mm.fail("mmock: %s() CallArg [%d]: invalid arg index", c.Method, index)
+		return
+	} else if c.method == nil {
+		return
+	}
+	ins := c.method.NumIn()
+	var at reflect.Type
+	if c.method.IsVariadic() && index >= ins-1 {
+		at = c.method.In(ins - 1).Elem()
+	} else if index < ins {
+		at = c.method.In(index)
+	} else {
+		c.mm.fail("mmock: %s() CallArg [%d]: method only has %d args", c.Method, index, ins)
+		return
+	}
+	if at.Kind() == reflect.Func {
+		if _, msg := funcArgValues(at, args); msg != "" {
+			c.mm.fail("mmock: %s() CallArg [%d]: %s", c.Method, index, msg)
+		}
+	} else if at.Kind() != reflect.Interface {
+		c.mm.fail("mmock: %s() CallArg [%d]: arg of type %s is not a func", c.Method, index, at.String())
+	}
+}
+
+// applyArgCalls calls the func args of a call (see CallArg)
+func (c *Call) applyArgCalls(argCalls []argCall, args mock.Arguments) {
+	args = c.expandVariadic(args)
+	for _, ac := range argCalls {
+		if ac.index >= len(args) {
+			c.mm.fail("mmock: %s() CallArg [%d]: call only has %d args", c.Method, ac.index, len(args))
+			return
+		}
+		fv := reflect.ValueOf(args[ac.index])
+		if !fv.IsValid() || fv.Kind() != reflect.Func {
+			c.mm.fail("mmock: %s() CallArg [%d]: arg of type %T is not a func", c.Method, ac.index, args[ac.index])
+			return
+		} else if fv.IsNil() {
+			c.mm.fail("mmock: %s() CallArg [%d]: func arg is nil", c.Method, ac.index)
+			return
+		}
+		ins := make([][]reflect.Value, len(ac.argSets))
+		for i, argSet := range ac.argSets {
+			in, msg := funcArgValues(fv.Type(), argSet)
+			if msg != "" {
+				c.mm.fail("mmock: %s() CallArg [%d]: %s", c.Method, ac.index, msg)
+				return
+			}
+			ins[i] = in
+		}
+		if ac.async {
+			c.argCallsWg.Add(1)
+			go func(index int, argSets [][]any) {
+				defer c.argCallsWg.Done()
+				c.callArg(fv, index, argSets, ins)
+			}(ac.index, ac.argSets)
+		} else {
+			c.callArg(fv, ac.index, ac.argSets, ins)
+		}
+	}
+}
+
+func (c *Call) callArg(fv reflect.Value, index int, argSets [][]any, ins [][]reflect.Value) {
+	for i, in := range ins {
+		results := fv.Call(in)
+		returns := make([]any, len(results))
+		for ri, r := range results {
+			returns[ri] = r.Interface()
+		}
+		c.mm.lock.Lock()
+		c.argCallResults = append(c.argCallResults, ArgCallResult{Index: index, Args: argSets[i], Returns: returns})
+		c.mm.lock.Unlock()
+	}
+}
+
+// funcArgValues converts args to the arg types of a func - returning a failure message if they cannot be converted
+func funcArgValues(ft reflect.Type, args []any) ([]reflect.Value, string) {
+	fixed := ft.NumIn()
+	if ft.IsVariadic() {
+		fixed--
+	}
+	if len(args) < fixed || (!ft.IsVariadic() && len(args) != fixed) {
+		return nil, fmt.Sprintf("func expects %d args but got %d", ft.NumIn(), len(args))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var at reflect.Type
+		if i >= fixed {
+			at = ft.In(fixed).Elem()
+		} else {
+			at = ft.In(i)
+		}
+		v, ok := argValue(arg, at)
+		if !ok {
+			return nil, fmt.Sprintf("func arg [%d]: expected type %s but got %T", i, at.String(), arg)
+		}
+		in[i] = v
+	}
+	return in, ""
+}
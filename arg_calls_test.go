@@ -0,0 +1,127 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+type walkerMock struct {
+	MockMethods
+}
+
+func (m *walkerMock) Walk(fn func(path string) error) error {
+	return As1[error](m.Called(fn))
+}
+
+func (m *walkerMock) Subscribe(topic string, handler func(msg string, ids ...int) error) {
+	m.Called(topic, handler)
+}
+
+func (m *walkerMock) Each(fns ...func(int)) {
+	m.Called(fns)
+}
+
+func TestCall_CallArg(t *testing.T) {
+	m := NewMock[walkerMock]()
+	call := m.OnMethod(m.Walk).CallArg(0, "/a").Return(nil)
+
+	paths := make([]string, 0)
+	err := m.Walk(func(path string) error {
+		paths = append(paths, path)
+		return errors.New("stop")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a"}, paths)
+	results := call.ArgCalls()
+	require.Equal(t, 1, len(results))
+	assert.Equal(t, 0, results[0].Index)
+	assert.Equal(t, []any{"/a"}, results[0].Args)
+	assert.EqualError(t, results[0].Returns[0].(error), "stop")
+}
+
+func TestCall_CallArgSequence(t *testing.T) {
+	m := NewMock[walkerMock]()
+	call := m.OnMethod(m.Subscribe).CallArgSequence(1, []any{"a"}, []any{"b", 1, 2}, []any{"c", nil})
+
+	msgs := make([]string, 0)
+	ids := make([]int, 0)
+	m.Subscribe("topic", func(msg string, is ...int) error {
+		msgs = append(msgs, msg)
+		ids = append(ids, is...)
+		return nil
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, msgs)
+	assert.Equal(t, []int{1, 2, 0}, ids)
+	assert.Equal(t, 3, len(call.ArgCalls()))
+}
+
+func TestCall_CallArgAsync(t *testing.T) {
+	m := NewMock[walkerMock]()
+	call := m.OnMethod(m.Walk).CallArgSequenceAsync(0, []any{"/a"}, []any{"/b"}).Return(nil)
+
+	var mutex sync.Mutex
+	paths := make([]string, 0)
+	require.NoError(t, m.Walk(func(path string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		paths = append(paths, path)
+		return nil
+	}))
+	call.WaitArgCalls()
+	assert.Equal(t, []string{"/a", "/b"}, paths)
+	assert.Equal(t, 2, len(call.ArgCalls()))
+
+	m = NewMock[walkerMock]()
+	call = m.OnMethod(m.Walk).CallArgAsync(0, "/c").Return(nil)
+	require.NoError(t, m.Walk(func(path string) error {
+		return nil
+	}))
+	call.WaitArgCalls()
+	assert.Equal(t, []any{"/c"}, call.ArgCalls()[0].Args)
+}
+
+func TestCall_CallArg_VariadicMethod(t *testing.T) {
+	m := NewMock[walkerMock]()
+	m.OnMethod(m.Each).CallArg(1, 2)
+
+	called := 0
+	m.Each(func(i int) {
+		called += 10
+	}, func(i int) {
+		called += i
+	})
+	assert.Equal(t, 2, called)
+}
+
+func TestCall_CallArg_Validates(t *testing.T) {
+	m := NewMock[walkerMock]()
+	assert.PanicsWithValue(t, "mmock: Subscribe() CallArg [0]: arg of type string is not a func", func() {
+		m.OnMethod(m.Subscribe).CallArg(0, "a")
+	})
+	assert.PanicsWithValue(t, "mmock: Walk() CallArg [0]: func expects 1 args but got 2", func() {
+		m.OnMethod(m.Walk).CallArg(0, "a", "b")
+	})
+	assert.PanicsWithValue(t, "mmock: Subscribe() CallArg [1]: func arg [1]: expected type int but got string", func() {
+		m.OnMethod(m.Subscribe).CallArgSequence(1, []any{"a", 1}, []any{"b", "c"})
+	})
+	assert.PanicsWithValue(t, "mmock: Walk() CallArg [1]: method only has 1 args", func() {
+		m.OnMethod(m.Walk).CallArg(1)
+	})
+}
+
+func TestCall_CallArg_Failures(t *testing.T) {
+	m := NewMock[walkerMock]()
+	m.OnMethod(m.Walk).CallArg(0, "/a").Return(nil)
+	assert.PanicsWithValue(t, "mmock: Walk() CallArg [0]: func arg is nil", func() {
+		_ = m.Walk(nil)
+	})
+
+	m = NewMock[walkerMock]()
+	m.OnMethod(m.Each).CallArg(1, 1)
+	assert.PanicsWithValue(t, "mmock: Each() CallArg [1]: call only has 1 args", func() {
+		m.Each(func(i int) {})
+	})
+}
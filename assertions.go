@@ -0,0 +1,192 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"sort"
+	"strings"
+)
+
+// CallCount is an expected number of calls (see MockMethods.AssertMethodCalledTimes)
+type CallCount struct {
+	min int
+	max int // -1 for no maximum
+}
+
+// Exactly is an expected number of calls of exactly n
+//
+// Panics if n is negative
+func Exactly(n int) CallCount {
+	checkCallCount("Exactly", "n", n)
+	return CallCount{min: n, max: n}
+}
+
+// AtLeast is an expected number of calls of at least n
+//
+// Panics if n is negative
+func AtLeast(n int) CallCount {
+	checkCallCount("AtLeast", "n", n)
+	return CallCount{min: n, max: -1}
+}
+
+// AtMost is an expected number of calls of at most n
+//
+// Panics if n is negative
+func AtMost(n int) CallCount {
+	checkCallCount("AtMost", "n", n)
+	return CallCount{min: 0, max: n}
+}
+
+// Between is an expected number of calls of between lower and upper (inclusive)
+//
+// Panics if lower is negative or lower is greater than upper
+func Between(lower int, upper int) CallCount {
+	checkCallCount("Between", "lower", lower)
+	if lower > upper {
+		panic(fmt.Sprintf("mmock: Between lower must not be greater than upper but got %d and %d", lower, upper))
+	}
+	return CallCount{min: lower, max: upper}
+}
+
+func checkCallCount(fn string, name string, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("mmock: %s %s must not be negative but got %d", fn, name, n))
+	}
+}
+
+// Matches determines whether the actual number of calls matches the expected number of calls
+func (c CallCount) Matches(calls int) bool {
+	return calls >= c.min && (c.max == -1 || calls <= c.max)
+}
+
+// String returns a description of the expected number of calls (e.g. "at least 2")
+func (c CallCount) String() string {
+	switch {
+	case c.min == c.max:
+		return fmt.Sprintf("exactly %d", c.min)
+	case c.max == -1:
+		return fmt.Sprintf("at least %d", c.min)
+	case c.min == 0:
+		return fmt.Sprintf("at most %d", c.max)
+	}
+	return fmt.Sprintf("between %d and %d", c.min, c.max)
+}
+
+// AssertMethodCalledTimes asserts that a method was called the expected number of times
+//
+// The method can be specified by func pointer or name, e.g.
+//
+//	myMock.AssertMethodCalledTimes(t, myMock.SomeMethod, mmock.AtLeast(2))
+//
+//go:noinline
+func (mm *MockMethods) AssertMethodCalledTimes(t mock.TestingT, method any, count CallCount) bool {
+	methodName, _ := mm.getMethod(method)
+	calls := mm.callsOf(methodName)
+	if count.Matches(len(calls)) {
+		mm.verify(calls...)
+		return true
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf("mmock: expected %s() to be called %s times but it was called %d times", methodName, count.String(), len(calls))
+	return false
+}
+
+// AssertMethodCalledOnce asserts that a method was called exactly once with the specified args
+//
+// The method can be specified by func pointer or name - and if the number of arguments specified is less than the
+// expected args of the method then the arguments is padded with mock.Anything
+//
+//go:noinline
+func (mm *MockMethods) AssertMethodCalledOnce(t mock.TestingT, method any, arguments ...any) bool {
+	methodName, ins := mm.getMethodNameAndNumArgs(method)
+	arguments, captors := replaceCaptors(padArguments(arguments, ins))
+	matched := mm.matchingCalls(methodName, arguments)
+	if len(matched) == 1 {
+		captureRecords(captors, matched[0])
+		mm.verify(matched...)
+		return true
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if len(matched) == 0 {
+		t.Errorf("%s", mm.notCalledReport(methodName, arguments))
+	} else {
+		t.Errorf("mmock: expected %s to be called once but it was called %d times", callString(methodName, arguments), len(matched))
+	}
+	return false
+}
+
+// AssertNthMethodCall asserts that the nth call (where n = 1 is the first call) of a method was made with the specified args
+//
+// The method can be specified by func pointer or name - and if the number of arguments specified is less than the
+// expected args of the method then the arguments is padded with mock.Anything
+//
+//go:noinline
+func (mm *MockMethods) AssertNthMethodCall(t mock.TestingT, method any, n int, arguments ...any) bool {
+	methodName, ins := mm.getMethodNameAndNumArgs(method)
+	arguments, captors := replaceCaptors(padArguments(arguments, ins))
+	calls := mm.callsOf(methodName)
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if n < 1 || n > len(calls) {
+		t.Errorf("mmock: %s() was called %d times - there is no call %d", methodName, len(calls), n)
+		return false
+	}
+	record := calls[n-1]
+	if _, differences := mock.Arguments(arguments).Diff(record.Arguments); differences > 0 {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("mmock: call %d of %s() did not match: %s\n\tactual call %s", n, methodName, callString(methodName, arguments), callString(methodName, record.Arguments)))
+		writeArgumentsTable(&sb, arguments, record.Arguments)
+		t.Errorf("%s", sb.String())
+		return false
+	}
+	captureRecords(captors, record)
+	mm.verify(record)
+	return true
+}
+
+// AssertNoMoreInteractions asserts that every call made to the mock has been verified by an assertion
+//
+// A call is verified when it has been matched by AssertMethodCalled, AssertMethodCalledOnce, AssertNthMethodCall,
+// AssertMethodCalledTimes or AssertNumberOfMethodCalls
+func (mm *MockMethods) AssertNoMoreInteractions(t mock.TestingT) bool {
+	mm.lock.Lock()
+	records := make([]*CallRecord, 0)
+	for _, record := range mm.records {
+		if !mm.verified[record.Sequence] {
+			records = append(records, record)
+		}
+	}
+	mm.lock.Unlock()
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Sequence < records[j].Sequence
+	})
+	unverified := make([]string, len(records))
+	for i, record := range records {
+		unverified[i] = callString(record.Method, record.Arguments)
+	}
+	if len(unverified) == 0 {
+		return true
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf("mmock: %d call(s) not verified:\n\t%s", len(unverified), strings.Join(unverified, "\n\t"))
+	return false
+}
+
+// verify marks calls as having been verified by an assertion (see AssertNoMoreInteractions)
+func (mm *MockMethods) verify(records ...CallRecord) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if mm.verified == nil {
+		mm.verified = map[uint64]bool{}
+	}
+	for _, record := range records {
+		mm.verified[record.Sequence] = true
+	}
+}
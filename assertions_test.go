@@ -0,0 +1,162 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCallCount(t *testing.T) {
+	testCases := []struct {
+		count    CallCount
+		expect   string
+		matches  []int
+		mismatch []int
+	}{
+		{Exactly(2), "exactly 2", []int{2}, []int{0, 1, 3}},
+		{AtLeast(2), "at least 2", []int{2, 3, 100}, []int{0, 1}},
+		{AtMost(2), "at most 2", []int{0, 1, 2}, []int{3}},
+		{Between(1, 3), "between 1 and 3", []int{1, 2, 3}, []int{0, 4}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expect, tc.count.String())
+		for _, n := range tc.matches {
+			assert.True(t, tc.count.Matches(n))
+		}
+		for _, n := range tc.mismatch {
+			assert.False(t, tc.count.Matches(n))
+		}
+	}
+}
+
+func TestCallCount_InvalidBounds(t *testing.T) {
+	assert.PanicsWithValue(t, "mmock: Exactly n must not be negative but got -1", func() {
+		Exactly(-1)
+	})
+	assert.PanicsWithValue(t, "mmock: AtLeast n must not be negative but got -1", func() {
+		AtLeast(-1)
+	})
+	assert.PanicsWithValue(t, "mmock: AtMost n must not be negative but got -2", func() {
+		AtMost(-2)
+	})
+	assert.PanicsWithValue(t, "mmock: Between lower must not be negative but got -1", func() {
+		Between(-1, 2)
+	})
+	assert.PanicsWithValue(t, "mmock: Between lower must not be greater than upper but got 3 and 1", func() {
+		Between(3, 1)
+	})
+	assert.NotPanics(t, func() {
+		Exactly(0)
+		Between(2, 2)
+	})
+}
+
+func TestMockMethods_AssertMethodCalledTimes(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+	assert.True(t, m.AssertMethodCalledTimes(t, m.Delete, AtLeast(2)))
+	assert.True(t, m.AssertMethodCalledTimes(t, "Delete", Between(1, 2)))
+	assert.True(t, m.AssertMethodCalledTimes(t, m.Save, AtMost(0)))
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertMethodCalledTimes(ft, m.Delete, AtMost(1)))
+	assert.Equal(t, []string{"mmock: expected Delete() to be called at most 1 times but it was called 2 times"}, ft.errors)
+}
+
+func TestMockMethods_AssertMethodCalledOnce(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+	_ = m.Delete("b")
+	key := Captor[string]()
+	assert.True(t, m.AssertMethodCalledOnce(t, m.Delete, "a"))
+	assert.True(t, m.AssertMethodCalledOnce(t, m.Delete, mock.MatchedBy(func(s string) bool { return s == "a" })))
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertMethodCalledOnce(ft, m.Delete, "b"))
+	assert.False(t, m.AssertMethodCalledOnce(ft, m.Delete, key))
+	assert.False(t, m.AssertMethodCalledOnce(ft, m.Delete, "c"))
+	assert.Equal(t, 3, len(ft.errors))
+	assert.Equal(t, `mmock: expected Delete("b") to be called once but it was called 2 times`, ft.errors[0])
+	assert.Equal(t, `mmock: expected Delete(func(string) bool) to be called once but it was called 3 times`, ft.errors[1])
+	assert.Contains(t, ft.errors[2], `mmock: expected call was not made: Delete("c")`)
+	assert.Equal(t, 0, key.Len())
+}
+
+func TestMockMethods_AssertNthMethodCall(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Save).Return(nil)
+	_ = m.Save("a", 1, nil)
+	_ = m.Save("b", 2, &diagnosticStruct{Name: "x"})
+	value := Captor[*diagnosticStruct]()
+	assert.True(t, m.AssertNthMethodCall(t, m.Save, 1, "a"))
+	assert.True(t, m.AssertNthMethodCall(t, m.Save, 2, "b", 2, value))
+	assert.Equal(t, "x", value.Last().Name)
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertNthMethodCall(ft, m.Save, 2, "b", 2, &diagnosticStruct{Name: "y"}))
+	assert.False(t, m.AssertNthMethodCall(ft, m.Save, 3))
+	assert.Equal(t, 2, len(ft.errors))
+	assert.Equal(t, "mmock: call 2 of Save() did not match: Save(\"b\", 2, &{y [] map[] <nil>})\n"+
+		"\tactual call Save(\"b\", 2, &{x [] map[] <nil>})\n"+
+		"\t\targ [0]: ok\n"+
+		"\t\targ [1]: ok\n"+
+		"\t\targ [2]: MISMATCH\n"+
+		"\t\t\t.Name: expected \"y\" but got \"x\"", ft.errors[0])
+	assert.Equal(t, "mmock: Save() was called 2 times - there is no call 3", ft.errors[1])
+}
+
+func TestMockMethods_AssertNoMoreInteractions(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Save).Return(nil)
+	m.OnMethod(m.Delete).Return(nil)
+	assert.True(t, m.AssertNoMoreInteractions(t))
+	_ = m.Save("a", 1, nil)
+	_ = m.Save("b", 2, nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+
+	m.AssertMethodCalled(t, m.Save, "a")
+	m.AssertNthMethodCall(t, m.Delete, 2, "b")
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertNoMoreInteractions(ft))
+	assert.Equal(t, []string{"mmock: 2 call(s) not verified:\n\tSave(\"b\", 2, <nil>)\n\tDelete(\"a\")"}, ft.errors)
+
+	m.AssertNumberOfMethodCalls(t, m.Save, 2)
+	m.AssertMethodCalledOnce(t, m.Delete, "a")
+	assert.True(t, m.AssertNoMoreInteractions(t))
+}
+
+func TestMockMethods_AssertNoMoreInteractions_FailedAssertionsDoNotVerify(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	ft := &fakeTB{TB: t}
+	m.AssertMethodCalledTimes(ft, m.Delete, Exactly(2))
+	m.AssertNumberOfMethodCalls(ft, m.Delete, 2)
+	m.AssertMethodCalledOnce(ft, m.Delete, "b")
+	assert.False(t, m.AssertNoMoreInteractions(ft))
+	assert.Equal(t, 4, len(ft.errors))
+}
+
+func TestMockMethods_CallCountsFromRecords(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.SetNice(true)
+	m.OnMethod(m.Delete, "a").Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b") // nice mock recorded call
+
+	assert.True(t, m.AssertNumberOfMethodCalls(t, m.Delete, 2))
+	assert.True(t, m.AssertMethodCalledTimes(t, m.Delete, Exactly(2)))
+	assert.True(t, m.AssertMethodNotCalled(t, m.Delete, "c"))
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertNumberOfMethodCalls(ft, m.Delete, 1))
+	assert.False(t, m.AssertMethodNotCalled(ft, m.Delete, "b"))
+	assert.Equal(t, []string{
+		"mmock: expected Delete() to be called 1 times but it was called 2 times",
+		"mmock: expected Delete(\"b\") not to be called but it was called 1 times",
+	}, ft.errors)
+}
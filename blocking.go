@@ -0,0 +1,214 @@
+package mmock
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// BlockUntilCancelled sets the call to block until the context.Context arg of the call is done - the call then returns
+// the context's error (ctx.Err()) in the error result of the method, e.g.
+//
+//	myMock.OnMethod(myMock.Fetch).BlockUntilCancelled()
+//
+// Useful for testing that code honours context cancellation (and timeouts) - fails if the method has no context.Context arg
+// or no error result
+func (c *Call) BlockUntilCancelled() *Call {
+	if c.method != nil && contextParamIndex(c.method) == -1 {
+		c.mm.fail("mmock: %s() BlockUntilCancelled method has no context.Context arg", c.Method)
+		return c
+	} else if !c.validateBlocking("BlockUntilCancelled") {
+		return c
+	}
+	c.setBlockFn(func(ctx context.Context) error {
+		if ctx == nil {
+			c.mm.fail("mmock: %s() BlockUntilCancelled call has no context.Context arg", c.Method)
+			return nil
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	return c
+}
+
+// BlockFor sets the call to block for the specified duration - or until the context.Context arg of the call (if any) is done,
+// in which case the call returns the context's error (ctx.Err()) in the error result of the method
+//
+// Unlike After, the call does not block beyond the caller's context (the duration uses the mock's clock - see MockMethods.SetClock)
+func (c *Call) BlockFor(d time.Duration) *Call {
+	if !c.validateBlocking("BlockFor") {
+		return c
+	}
+	c.setBlockFn(func(ctx context.Context) error {
+		timer := c.mm.getClock().NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C():
+			return nil
+		case <-done(ctx):
+			return ctx.Err()
+		}
+	})
+	return c
+}
+
+// BlockOn sets the call to block until the gate is released - or until the context.Context arg of the call (if any) is done,
+// in which case the call returns the context's error (ctx.Err()) in the error result of the method
+//
+// Example:
+//
+//	gate := mmock.NewGate()
+//	myMock.OnMethod(myMock.Fetch).BlockOn(gate).Return(data, nil)
+//	go func() { ... }() // code that calls Fetch
+//	gate.AwaitWaiting(1, time.Second) // the call is now in-flight
+//	myMock.AssertMethodCalled(t, myMock.Fetch) // the in-flight call is already recorded
+//	gate.Release()
+func (c *Call) BlockOn(gate *Gate) *Call {
+	if !c.validateBlocking("BlockOn") {
+		return c
+	}
+	c.setBlockFn(gate.wait)
+	return c
+}
+
+// validateBlocking checks that the method of a blocking call can return the context's error (and fails if it cannot)
+func (c *Call) validateBlocking(label string) bool {
+	if c.method != nil && contextParamIndex(c.method) != -1 && errorResultIndex(c.method) == -1 {
+		c.mm.fail("mmock: %s() %s method has no error result (to return the context error)", c.Method, label)
+		return false
+	}
+	return true
+}
+
+func (c *Call) setBlockFn(fn func(ctx context.Context) error) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.blockFn = fn
+}
+
+// block blocks a call (see BlockUntilCancelled, BlockFor and BlockOn) - returning the context's error if the call was cancelled
+func (c *Call) block(blockFn func(ctx context.Context) error, args mock.Arguments) error {
+	return blockFn(c.contextArg(args))
+}
+
+// setContextError sets the error result of a cancelled blocking call to the context's error
+func (c *Call) setContextError(args mock.Arguments, err error) {
+	if c.method == nil {
+		c.mm.fail("mmock: %s() cannot return the context error (mock type not known)", c.Method)
+		return
+	}
+	inv := c.mm.invocationOf(args)
+	if inv == nil {
+		return
+	}
+	returns := inv.returns
+	if !inv.hasReturns {
+		returns = c.Call.ReturnArguments
+	}
+	result := make(mock.Arguments, c.method.NumOut())
+	copy(result, returns)
+	result[errorResultIndex(c.method)] = err
+	inv.returns, inv.hasReturns = result, true
+}
+
+// contextArg returns the context.Context arg of a call (or nil if the call has no context arg)
+func (c *Call) contextArg(args mock.Arguments) context.Context {
+	for i, arg := range args {
+		if ctx, ok := arg.(context.Context); ok && (c.method == nil || (i < c.method.NumIn() && c.method.In(i) == contextType)) {
+			return ctx
+		}
+	}
+	return nil
+}
+
+// contextParamIndex returns the index of the first context.Context param of a method (or -1 if there is none)
+func contextParamIndex(method reflect.Type) int {
+	for i := 0; i < method.NumIn(); i++ {
+		if method.In(i) == contextType {
+			return i
+		}
+	}
+	return -1
+}
+
+// done returns the done channel of a context (or nil if there is no context - so that it is never done)
+func done(ctx context.Context) <-chan struct{} {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Done()
+}
+
+// Gate holds calls in-flight until released (see Call.BlockOn)
+type Gate struct {
+	lock     sync.Mutex
+	released chan struct{}
+	changed  chan struct{}
+	waiting  int
+}
+
+// NewGate creates a new (unreleased) Gate
+func NewGate() *Gate {
+	return &Gate{
+		released: make(chan struct{}),
+		changed:  make(chan struct{}),
+	}
+}
+
+// Release releases the calls held by the gate (and any further calls will not be held)
+func (g *Gate) Release() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	select {
+	case <-g.released:
+	default:
+		close(g.released)
+	}
+}
+
+// Waiting returns the number of calls currently held by the gate
+func (g *Gate) Waiting() int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.waiting
+}
+
+// AwaitWaiting waits until at least n calls are held by the gate - returning false if the timeout elapses first
+func (g *Gate) AwaitWaiting(n int, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		g.lock.Lock()
+		waiting, changed := g.waiting, g.changed
+		g.lock.Unlock()
+		if waiting >= n {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+func (g *Gate) addWaiting(delta int) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.waiting += delta
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+func (g *Gate) wait(ctx context.Context) error {
+	g.addWaiting(1)
+	defer g.addWaiting(-1)
+	select {
+	case <-g.released:
+		return nil
+	case <-done(ctx):
+		return ctx.Err()
+	}
+}
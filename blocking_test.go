@@ -0,0 +1,156 @@
+package mmock
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCall_BlockUntilCancelled(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	m.OnMethod(m.Fetch).BlockUntilCancelled().Return("data", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	r, err := m.Fetch(ctx, "a")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, "data", r)
+	calls := m.CallsOf(m.Fetch)
+	require.Equal(t, 1, len(calls))
+	assert.Equal(t, context.DeadlineExceeded, calls[0].Returns[1])
+}
+
+func TestCall_BlockUntilCancelled_NoContext(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	assert.PanicsWithValue(t, "mmock: Ping() BlockUntilCancelled method has no context.Context arg", func() {
+		m.OnMethod(m.Ping).BlockUntilCancelled()
+	})
+	um := &fetcherMock{}
+	um.OnMethod("Ping").BlockUntilCancelled()
+	assert.PanicsWithValue(t, "mmock: Ping() BlockUntilCancelled call has no context.Context arg", func() {
+		_ = um.Ping()
+	})
+}
+
+func (m *fetcherMock) Peek(ctx context.Context) string {
+	return As1[string](m.Called(ctx))
+}
+
+func TestCall_Block_NoErrorResult(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	assert.PanicsWithValue(t, "mmock: Peek() BlockUntilCancelled method has no error result (to return the context error)", func() {
+		m.OnMethod(m.Peek).BlockUntilCancelled()
+	})
+	assert.PanicsWithValue(t, "mmock: Peek() BlockFor method has no error result (to return the context error)", func() {
+		m.OnMethod(m.Peek).BlockFor(time.Second)
+	})
+	assert.PanicsWithValue(t, "mmock: Peek() BlockOn method has no error result (to return the context error)", func() {
+		m.OnMethod(m.Peek).BlockOn(NewGate())
+	})
+}
+
+func TestCall_BlockUntilCancelled_WithReturnSequence(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	m.OnMethod(m.Fetch).BlockUntilCancelled().ReturnSequence(Ret("a", nil), Ret("b", nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r, err := m.Fetch(ctx, "a")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, "a", r)
+}
+
+func TestCall_BlockFor(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	m.OnMethod(m.Fetch).BlockFor(10*time.Millisecond).Return("data", nil)
+
+	start := time.Now()
+	r, err := m.Fetch(context.Background(), "a")
+	require.NoError(t, err)
+	assert.Equal(t, "data", r)
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+
+	m = NewMock[fetcherMock]()
+	m.OnMethod(m.Fetch).BlockFor(time.Minute).Return("data", nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = m.Fetch(ctx, "a")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	m = NewMock[fetcherMock]()
+	m.OnMethod(m.Ping).BlockFor(time.Millisecond).Return(nil)
+	assert.NoError(t, m.Ping())
+}
+
+func TestCall_BlockOn(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	gate := NewGate()
+	m.OnMethod(m.Fetch).BlockOn(gate).Return("data", nil)
+
+	results := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := m.Fetch(context.Background(), "a")
+			results <- err
+		}()
+	}
+	require.True(t, gate.AwaitWaiting(2, time.Second))
+	assert.Equal(t, 2, gate.Waiting())
+	select {
+	case <-results:
+		assert.Fail(t, "call should be held by gate")
+	default:
+	}
+	// the held calls can be asserted...
+	assert.True(t, m.AssertMethodCalled(t, m.Fetch, mock.Anything, "a"))
+	assert.True(t, m.AssertNumberOfMethodCalls(t, m.Fetch, 2))
+	assert.Equal(t, 2, m.Expectations()[0].Calls)
+	gate.Release()
+	gate.Release()
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, <-results)
+	}
+	assert.Equal(t, 0, gate.Waiting())
+	// released gate does not hold further calls...
+	_, err := m.Fetch(context.Background(), "a")
+	assert.NoError(t, err)
+}
+
+func TestCall_BlockOn_Cancelled(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	gate := NewGate()
+	m.OnMethod(m.Fetch).BlockOn(gate).Return("data", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := make(chan error, 1)
+	go func() {
+		_, err := m.Fetch(ctx, "a")
+		results <- err
+	}()
+	require.True(t, gate.AwaitWaiting(1, time.Second))
+	cancel()
+	assert.Equal(t, context.Canceled, <-results)
+	assert.False(t, gate.AwaitWaiting(1, time.Millisecond))
+}
+
+func TestCall_BlockFor_AssertWhileBlocked(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	clock := NewFakeClock(clockStart)
+	m.SetClock(clock)
+	m.OnMethod(m.Fetch).BlockFor(time.Minute).Return("data", nil)
+
+	results := make(chan error, 1)
+	go func() {
+		_, err := m.Fetch(context.Background(), "a")
+		results <- err
+	}()
+	require.True(t, clock.AwaitWaiters(1, time.Second))
+	assert.True(t, m.AssertMethodCalled(t, m.Fetch, mock.Anything, "a"))
+	assert.True(t, m.AssertNumberOfMethodCalls(t, m.Fetch, 1))
+	clock.Advance(time.Minute)
+	assert.NoError(t, <-results)
+	assert.Equal(t, time.Minute, m.LastCall(m.Fetch).Duration)
+}
@@ -0,0 +1,303 @@
+package mmock
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Call is the expected call returned by MockMethods.OnMethod
+//
+// It is the same as mock.Call (https://pkg.go.dev/github.com/stretchr/testify/mock#Call) except that
+// Return values are validated against the signature of the mocked method
+type Call struct {
+	*mock.Call
+	mm       *MockMethods
+	method   reflect.Type // the func type of the mocked method (nil if not known)
+	captors  []argCaptor
+	setters  []argSetter
+	argCalls []argCall
+	runFn    func(args mock.Arguments)
+	returnFn func(args mock.Arguments) mock.Arguments // dynamic return values (e.g. ReturnSequence)
+	sequence *returnSequence
+	exitFn   func() // simulated panic or runtime.Goexit (see Panic)
+	blockFn  func(ctx context.Context) error
+	after    time.Duration
+	faults   []*FaultPolicy
+	order    *orderedGroup
+	source   string // where the expectation was registered
+	name     string // see Named
+	calls    int    // the number of calls matched to the expectation
+	times    int    // the number of calls expected (0 for any number - see Once, Times etc.)
+	optional bool   // see Maybe
+	// results of func args called (see CallArg)
+	argCallResults []ArgCallResult
+	argCallsWg     sync.WaitGroup
+}
+
+func newCall(mm *MockMethods, call *mock.Call, method reflect.Type, captors []argCaptor, source string) *Call {
+	c := &Call{
+		Call:    call,
+		mm:      mm,
+		method:  method,
+		captors: captors,
+		source:  source,
+	}
+	c.Call.Run(c.run)
+	mm.addExpectation(c)
+	return c
+}
+
+// run is the mock.Call.RunFn of every Call - it applies the post-call behaviours (e.g. capturing args) before calling the Run func
+func (c *Call) run(args mock.Arguments) {
+	c.mm.lock.Lock()
+	after, captors, setters, argCalls, blockFn := c.after, c.captors, c.setters, c.argCalls, c.blockFn
+	runFn, returnFn, exitFn := c.runFn, c.returnFn, c.exitFn
+	c.calls++
+	c.mm.lock.Unlock()
+	c.mm.setMatchedCall(args, c)
+	if after > 0 {
+		c.mm.getClock().Sleep(after)
+	}
+	captureArguments(captors, args)
+	if len(setters) > 0 {
+		c.applyArgSetters(setters, args)
+	}
+	if len(argCalls) > 0 {
+		c.applyArgCalls(argCalls, args)
+	}
+	var blockErr error
+	if blockFn != nil {
+		blockErr = c.block(blockFn, args)
+	}
+	if returnFn != nil {
+		c.mm.setReturns(args, returnFn(args))
+	}
+	if runFn != nil {
+		runFn(args)
+	}
+	if blockErr != nil {
+		c.setContextError(args, blockErr)
+	}
+	if exitFn != nil {
+		c.mm.setSimulated(args)
+		exitFn()
+	}
+}
+
+// setArguments sets the expected args of the call (replacing any captors with matchers)
+func (c *Call) setArguments(arguments []any) {
+	arguments, captors := replaceCaptors(arguments)
+	c.mm.lock.Lock()
+	c.captors = captors
+	c.mm.lock.Unlock()
+	c.Call.Arguments = arguments
+}
+
+// Return is the same as mock.Call.Return() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Return)
+//
+// Except that the number of return values and their types are checked against the mocked method (and panics if they do not match)
+//
+// Note: nil can be used for any return value (and will be returned as the zero value) and values that As can
+// convert to the result type are allowed (e.g. an int for an int64 result)
+func (c *Call) Return(returnArguments ...any) *Call {
+	c.validateReturns("Return", returnArguments)
+	c.setReturnFn(nil)
+	c.Call.Return(returnArguments...)
+	return c
+}
+
+// validateReturns checks return values against the result types of the mocked method (and fails if they do not match)
+func (c *Call) validateReturns(label string, returnArguments []any) {
+	if c.method == nil {
+		return
+	}
+	if c.method.NumOut() != len(returnArguments) {
+		c.mm.fail("mmock: %s() %s expected %d values but got %d", c.Method, label, c.method.NumOut(), len(returnArguments))
+	}
+	for i, v := range returnArguments {
+		if v == nil {
+			continue
+		} else if _, ok := convertValue(reflect.ValueOf(v), c.method.Out(i)); !ok {
+			c.mm.fail("mmock: %s() %s value [%d]: expected type %s but got %T", c.Method, label, i, c.method.Out(i).String(), v)
+		}
+	}
+}
+
+func (c *Call) setReturnFn(fn func(args mock.Arguments) mock.Arguments) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.returnFn, c.sequence = fn, nil
+}
+
+// Once is the same as mock.Call.Once() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Once)
+func (c *Call) Once() *Call {
+	c.setTimes(1)
+	c.Call.Once()
+	return c
+}
+
+// Twice is the same as mock.Call.Twice() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Twice)
+func (c *Call) Twice() *Call {
+	c.setTimes(2)
+	c.Call.Twice()
+	return c
+}
+
+// Times is the same as mock.Call.Times() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Times)
+func (c *Call) Times(i int) *Call {
+	c.setTimes(i)
+	c.Call.Times(i)
+	return c
+}
+
+func (c *Call) setTimes(i int) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.times = i
+}
+
+// Maybe is the same as mock.Call.Maybe() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Maybe)
+func (c *Call) Maybe() *Call {
+	c.mm.lock.Lock()
+	c.optional = true
+	c.mm.lock.Unlock()
+	c.Call.Maybe()
+	return c
+}
+
+// Run is the same as mock.Call.Run() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Run)
+func (c *Call) Run(fn func(args mock.Arguments)) *Call {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.runFn = fn
+	return c
+}
+
+// Panic sets the call to panic with the specified value (after the call has been recorded and any Run func called)
+//
+// Unlike mock.Call.Panic(), the value can be of any type - and is never confused with testify's own failures
+// (so the panic propagates to the code under test, even on spy mocks)
+func (c *Call) Panic(value any) *Call {
+	if value == nil {
+		c.mm.fail("mmock: %s() Panic value must not be nil", c.Method)
+		return c
+	}
+	c.setExitFn(func() {
+		panic(value)
+	})
+	return c
+}
+
+// PanicWithError sets the call to panic with the specified error (see Panic)
+func (c *Call) PanicWithError(err error) *Call {
+	if err == nil {
+		c.mm.fail("mmock: %s() PanicWithError error must not be nil", c.Method)
+		return c
+	}
+	return c.Panic(err)
+}
+
+// Goexit sets the call to call runtime.Goexit (after the call has been recorded and any Run func called)
+//
+// Note: the call must be made on a goroutine other than the test's goroutine
+func (c *Call) Goexit() *Call {
+	c.setExitFn(runtime.Goexit)
+	return c
+}
+
+func (c *Call) setExitFn(fn func()) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.exitFn = fn
+}
+
+// After is the same as mock.Call.After() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.After)
+//
+// Except that the delay uses the mock's clock (see MockMethods.SetClock)
+func (c *Call) After(d time.Duration) *Call {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.after = d
+	return c
+}
+
+// WaitUntil is the same as mock.Call.WaitUntil() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.WaitUntil)
+func (c *Call) WaitUntil(w <-chan time.Time) *Call {
+	c.Call.WaitUntil(w)
+	return c
+}
+
+// NotBefore is the same as mock.Call.NotBefore() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.NotBefore)
+func (c *Call) NotBefore(calls ...*Call) *Call {
+	mcs := make([]*mock.Call, len(calls))
+	for i, call := range calls {
+		mcs[i] = call.Call
+	}
+	c.Call.NotBefore(mcs...)
+	return c
+}
+
+// validateArguments checks the expected arguments against the mocked method (and fails if they do not match)
+func (mm *MockMethods) validateArguments(methodName string, method reflect.Type, arguments []any) {
+	if method == nil {
+		return
+	}
+	ins := method.NumIn()
+	if !method.IsVariadic() && len(arguments) > ins {
+		mm.fail("mmock: %s() expected at most %d args but got %d", methodName, ins, len(arguments))
+		return
+	}
+	for i, arg := range arguments {
+		if isArgumentMatcher(arg) {
+			continue
+		}
+		var at reflect.Type
+		if method.IsVariadic() && i >= ins-1 {
+			at = method.In(ins - 1).Elem()
+		} else {
+			at = method.In(i)
+		}
+		if c, ok := arg.(captor); ok {
+			if ct := c.capturedType(); !at.AssignableTo(ct) && !ct.AssignableTo(at) {
+				mm.fail("mmock: %s() arg [%d]: captor of type %s cannot capture type %s", methodName, i, ct.String(), at.String())
+			}
+		} else if arg == nil {
+			if !isNillable(at) {
+				mm.fail("mmock: %s() arg [%d]: expected type %s but got nil", methodName, i, at.String())
+			}
+		} else if !reflect.TypeOf(arg).AssignableTo(at) {
+			mm.fail("mmock: %s() arg [%d]: expected type %s but got %T", methodName, i, at.String(), arg)
+		}
+	}
+}
+
+var (
+	anythingOfType      = reflect.TypeOf(mock.AnythingOfType(""))
+	isType              = reflect.TypeOf(mock.IsType(nil))
+	functionalOptions   = reflect.TypeOf(mock.FunctionalOptions())
+	argumentMatcherType = reflect.TypeOf(mock.MatchedBy(func(any) bool { return true }))
+)
+
+// isArgumentMatcher determines whether an expected argument is one of testify's special argument matchers
+func isArgumentMatcher(arg any) bool {
+	if arg == mock.Anything {
+		return true
+	}
+	switch reflect.TypeOf(arg) {
+	case anythingOfType, isType, functionalOptions, argumentMatcherType:
+		return true
+	}
+	return false
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package mmock
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// CallRecord is a record of a call made to a mocked method (see MockMethods.CallsOf)
+type CallRecord struct {
+	// Method is the name of the method called
+	Method string
+	// Arguments are the actual arguments of the call
+	Arguments mock.Arguments
+	// Returns are the values returned by the call (nil while the call is in progress)
+	Returns mock.Arguments
+	// Sequence is the sequence number of the call - sequence numbers are increasing across all calls of all mocks (in the
+	// order the calls were made - not the order they returned)
+	Sequence uint64
+	// Time is when the call was made, not when it returned (according to the mock's clock - see MockMethods.SetClock)
+	Time time.Time
+	// Goroutine is the id of the goroutine that made the call
+	Goroutine uint64
+	// Duration is how long the call took (according to the mock's clock) - 0 while the call is in progress
+	Duration time.Duration
+}
+
+var callSequence uint64
+
+func newCallRecord(methodName string, arguments []any, now time.Time) *CallRecord {
+	return &CallRecord{
+		Method:    methodName,
+		Arguments: append(mock.Arguments{}, arguments...),
+		Sequence:  atomic.AddUint64(&callSequence, 1),
+		Time:      now,
+		Goroutine: goroutineID(),
+	}
+}
+
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// stack trace starts with "goroutine 123 [running]:"
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		id, _ := strconv.ParseUint(string(buf[:i]), 10, 64)
+		return id
+	}
+	return 0
+}
+
+// finishRecord completes the record of a call when the call returns (the call is recorded when it is made - see startInvocation)
+func (mm *MockMethods) finishRecord(record *CallRecord, returns mock.Arguments) {
+	duration := mm.getClock().Since(record.Time)
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	record.Returns, record.Duration = returns, duration
+}
+
+// CallsOf returns the records of the calls made to a method (in the order they were made)
+//
+// The method can be specified by func pointer or name
+//
+//go:noinline
+func (mm *MockMethods) CallsOf(method any) []CallRecord {
+	methodName, _ := mm.getMethod(method)
+	return mm.callsOf(methodName)
+}
+
+func (mm *MockMethods) callsOf(methodName string) []CallRecord {
+	mm.lock.Lock()
+	result := make([]CallRecord, 0, len(mm.records))
+	for _, record := range mm.records {
+		if record.Method == methodName {
+			result = append(result, *record)
+		}
+	}
+	mm.lock.Unlock()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Sequence < result[j].Sequence
+	})
+	return result
+}
+
+// NthCall returns the record of the nth call made to a method (where n = 1 is the first call)
+//
+// The method can be specified by func pointer or name - fails if the method has not been called at least n times
+//
+//go:noinline
+func (mm *MockMethods) NthCall(method any, n int) CallRecord {
+	methodName, _ := mm.getMethod(method)
+	calls := mm.callsOf(methodName)
+	if n < 1 || n > len(calls) {
+		mm.fail("mmock: %s() was called %d times - there is no call %d", methodName, len(calls), n)
+		return CallRecord{Method: methodName}
+	}
+	return calls[n-1]
+}
+
+// LastCall returns the record of the last call made to a method
+//
+// The method can be specified by func pointer or name - fails if the method has not been called
+//
+//go:noinline
+func (mm *MockMethods) LastCall(method any) CallRecord {
+	methodName, _ := mm.getMethod(method)
+	calls := mm.callsOf(methodName)
+	if len(calls) == 0 {
+		mm.fail("mmock: %s() was not called", methodName)
+		return CallRecord{Method: methodName}
+	}
+	return calls[len(calls)-1]
+}
+
+// ArgOf returns the arg (at the specified index) of a call record as the specified type
+//
+// Panics if the arg cannot be converted to the specified type (see As) or the index is out of range
+func ArgOf[T any](record CallRecord, index int) T {
+	if index < 0 || index >= len(record.Arguments) {
+		panic(fmt.Sprintf("mmock: %s() arg [%d]: index out of range (call has %d args)", record.Method, index, len(record.Arguments)))
+	}
+	r := record.Arguments[index]
+	if r != nil {
+		if v, ok := r.(T); ok {
+			return v
+		}
+		tt := reflect.TypeOf((*T)(nil)).Elem()
+		if cv, ok := convertValue(reflect.ValueOf(r), tt); ok {
+			return cv.Interface().(T)
+		}
+		panic(fmt.Sprintf("mmock: %s() arg [%d]: expected type %s but got %T", record.Method, index, tt.String(), r))
+	}
+	var rn T
+	return rn
+}
@@ -0,0 +1,185 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMockMethods_CallsOf(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Save).Return(nil)
+	errTest := errors.New("fails")
+	m.OnMethod(m.Delete).Return(errTest)
+	start := time.Now()
+	_ = m.Save("a", 1, &diagnosticStruct{Name: "x"})
+	_ = m.Delete("a")
+	_ = m.Save("b", 2, nil)
+
+	calls := m.CallsOf(m.Save)
+	require.Equal(t, 2, len(calls))
+	assert.Equal(t, "Save", calls[0].Method)
+	assert.Equal(t, "a", ArgOf[string](calls[0], 0))
+	assert.Equal(t, 1, ArgOf[int](calls[0], 1))
+	assert.Equal(t, &diagnosticStruct{Name: "x"}, ArgOf[*diagnosticStruct](calls[0], 2))
+	assert.Nil(t, ArgOf[*diagnosticStruct](calls[1], 2))
+	assert.Equal(t, 1, len(calls[0].Returns))
+	assert.Nil(t, calls[0].Returns[0])
+	assert.False(t, calls[0].Time.Before(start))
+	assert.NotZero(t, calls[0].Goroutine)
+	assert.Equal(t, calls[0].Goroutine, calls[1].Goroutine)
+
+	deletes := m.CallsOf("Delete")
+	require.Equal(t, 1, len(deletes))
+	assert.Equal(t, errTest, deletes[0].Returns[0])
+	assert.True(t, calls[0].Sequence < deletes[0].Sequence)
+	assert.True(t, deletes[0].Sequence < calls[1].Sequence)
+}
+
+func TestMockMethods_CallsOf_Goroutines(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = m.Delete("b")
+	}()
+	wg.Wait()
+	calls := m.CallsOf(m.Delete)
+	require.Equal(t, 2, len(calls))
+	assert.NotEqual(t, calls[0].Goroutine, calls[1].Goroutine)
+}
+
+func TestMockMethods_CallsOf_NiceAndSpy(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.SetNice(true)
+	_ = m.Delete("a")
+	assert.Equal(t, 1, len(m.CallsOf(m.Delete)))
+
+	underlying := &underlyingFull{
+		calls: map[string]int{},
+	}
+	spy := NewSpyMockOf[mockedMy, my](underlying)
+	_, _ = spy.DoSomething("x", 1)
+	calls := spy.CallsOf(spy.DoSomething)
+	require.Equal(t, 1, len(calls))
+	assert.Equal(t, 2, len(calls[0].Returns))
+	assert.Error(t, ArgOf[error](CallRecord{Arguments: calls[0].Returns}, 1))
+}
+
+func TestMockMethods_CallsOf_ExcludesUnexpected(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	assert.Panics(t, func() {
+		_ = m.Delete("a")
+	})
+	assert.Equal(t, 0, len(m.CallsOf(m.Delete)))
+}
+
+func TestMockMethods_NthCall_LastCall(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+	_ = m.Delete("c")
+	assert.Equal(t, "a", ArgOf[string](m.NthCall(m.Delete, 1), 0))
+	assert.Equal(t, "b", ArgOf[string](m.NthCall(m.Delete, 2), 0))
+	assert.Equal(t, "c", ArgOf[string](m.LastCall(m.Delete), 0))
+
+	assert.PanicsWithValue(t, "mmock: Delete() was called 3 times - there is no call 4", func() {
+		m.NthCall(m.Delete, 4)
+	})
+	assert.PanicsWithValue(t, "mmock: Save() was not called", func() {
+		m.LastCall(m.Save)
+	})
+}
+
+func TestArgOf(t *testing.T) {
+	record := CallRecord{Method: "Save", Arguments: []any{"a", 1, nil}}
+	assert.Equal(t, int64(1), ArgOf[int64](record, 1))
+	assert.Equal(t, "", ArgOf[string](record, 2))
+	assert.PanicsWithValue(t, "mmock: Save() arg [0]: expected type int but got string", func() {
+		ArgOf[int](record, 0)
+	})
+	assert.PanicsWithValue(t, "mmock: Save() arg [3]: index out of range (call has 3 args)", func() {
+		ArgOf[int](record, 3)
+	})
+}
+
+func TestMockMethods_CallsOf_TimeFromClock(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	clock := NewFakeClock(clockStart)
+	m.SetClock(clock)
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	clock.Advance(time.Minute)
+	_ = m.Delete("b")
+
+	calls := m.CallsOf(m.Delete)
+	require.Equal(t, 2, len(calls))
+	assert.Equal(t, clockStart, calls[0].Time)
+	assert.Equal(t, clockStart.Add(time.Minute), calls[1].Time)
+}
+
+func TestMockMethods_CallsOf_InProgress(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	release := make(chan time.Time)
+	m.OnMethod(m.Delete).WaitUntil(release).Return(nil)
+	done := make(chan struct{})
+	go func() {
+		_ = m.Delete("a")
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		return len(m.CallsOf(m.Delete)) == 1
+	}, time.Second, time.Millisecond)
+	// the call is still blocked...
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, "a"))
+	assert.True(t, m.AssertNumberOfMethodCalls(t, m.Delete, 1))
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertMethodNotCalled(ft, m.Delete))
+	assert.Nil(t, m.LastCall(m.Delete).Returns)
+
+	close(release)
+	<-done
+	calls := m.CallsOf(m.Delete)
+	require.Equal(t, 1, len(calls))
+	assert.Equal(t, 1, len(calls[0].Returns))
+	assert.True(t, calls[0].Duration > 0)
+}
+
+func TestMockMethods_CallsOf_OverlappingCalls(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	clock := NewFakeClock(clockStart)
+	m.SetClock(clock)
+	release := make(chan time.Time)
+	m.OnMethod(m.Delete, "a").WaitUntil(release).Return(nil)
+	m.OnMethod(m.Delete, "b").Return(nil)
+	done := make(chan struct{})
+	go func() {
+		_ = m.Delete("a")
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		return len(m.CallsOf(m.Delete)) == 1
+	}, time.Second, time.Millisecond)
+	clock.Advance(time.Minute)
+	_ = m.Delete("b") // returns before the first call
+	clock.Advance(time.Minute)
+	close(release)
+	<-done
+
+	calls := m.CallsOf(m.Delete)
+	require.Equal(t, 2, len(calls))
+	assert.Equal(t, "a", ArgOf[string](calls[0], 0))
+	assert.Equal(t, clockStart, calls[0].Time)
+	assert.Equal(t, 2*time.Minute, calls[0].Duration)
+	assert.Equal(t, "b", ArgOf[string](calls[1], 0))
+	assert.Equal(t, clockStart.Add(time.Minute), calls[1].Time)
+	assert.Equal(t, time.Duration(0), calls[1].Duration)
+	assert.True(t, calls[0].Sequence < calls[1].Sequence)
+}
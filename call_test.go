@@ -0,0 +1,164 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestCall_Return_Validates(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	assert.PanicsWithValue(t, "mmock: DoSomething() Return value [0]: expected type *mmock.SomeStruct but got mmock.SomeStruct", func() {
+		m.OnMethod(m.DoSomething).Return(SomeStruct{}, nil)
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() Return value [1]: expected type error but got string", func() {
+		m.OnMethod(m.DoSomething).Return(nil, "foo")
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() Return expected 2 values but got 1", func() {
+		m.OnMethod(m.DoSomething).Return(&SomeStruct{})
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() Return expected 2 values but got 1", func() {
+		m.OnMethod(m.DoSomething).Once().Return(&SomeStruct{})
+	})
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil)
+		m.OnMethod(m.DoSomethingElse).Return(nil, nil)
+		m.OnMethod("DoSomethingElse").Return(SomeStruct{}, nil)
+	})
+}
+
+func TestCall_Return_UnknownMethodType(t *testing.T) {
+	m := &mockedMy{}
+	// mock type not known, so cannot validate...
+	assert.NotPanics(t, func() {
+		m.OnMethod("DoSomething", "a", 1).Return(SomeStruct{})
+	})
+}
+
+func TestMockMethods_OnMethod_ValidatesArguments(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	assert.PanicsWithValue(t, "mmock: DoSomething() expected at most 2 args but got 3", func() {
+		m.OnMethod(m.DoSomething, "a", 1, 2)
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() arg [1]: expected type int but got int64", func() {
+		m.OnMethod(m.DoSomething, "a", int64(1))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() arg [0]: expected type string but got nil", func() {
+		m.OnMethod(m.DoSomething, nil)
+	})
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.DoSomething, mock.Anything, mock.AnythingOfType("int"))
+		m.OnMethod(m.DoSomething, mock.IsType(""), mock.MatchedBy(func(i int) bool { return true }))
+		m.OnMethod(m.DoSomething, "a", 1)
+	})
+}
+
+func TestMockMethods_OnMethod_ValidatesVariadicArguments(t *testing.T) {
+	m := NewMock[variadicMock]()
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.Sum, "a", 1, 2, 3, mock.Anything)
+	})
+	assert.PanicsWithValue(t, "mmock: Sum() arg [2]: expected type int but got string", func() {
+		m.OnMethod(m.Sum, "a", 1, "2")
+	})
+}
+
+func TestCall_Chaining(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	first := m.OnMethod(m.DoSomething, "a").Return(nil, nil).Once()
+	second := m.OnMethod(m.DoSomething, "b").Twice().Maybe().After(time.Millisecond).NotBefore(first).Return(nil, nil)
+	ch := make(chan time.Time)
+	close(ch)
+	ran := false
+	m.OnMethod(m.DoSomethingElse).Times(1).WaitUntil(ch).Run(func(args mock.Arguments) {
+		ran = true
+	}).Return(nil, nil)
+
+	assert.Equal(t, 1, first.Repeatability)
+	assert.Equal(t, 2, second.Repeatability)
+	_, _ = m.DoSomething("a", 1)
+	_, _ = m.DoSomething("b", 1)
+	_, _ = m.DoSomethingElse("c", 1)
+	assert.True(t, ran)
+}
+
+type variadicMock struct {
+	MockMethods
+}
+
+func (m *variadicMock) Sum(s string, nums ...int) int {
+	args := make([]any, 0)
+	args = append(args, s)
+	for _, v := range nums {
+		args = append(args, v)
+	}
+	return As1[int](m.Called(args...))
+}
+
+type panicValue struct {
+	code int
+}
+
+func TestCall_Panic(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething, "a").Panic(panicValue{code: 42})
+	ran := false
+	m.OnMethod(m.DoSomething, "b").Run(func(args mock.Arguments) {
+		ran = true
+	}).Panic("mock: not a testify failure")
+
+	assert.PanicsWithValue(t, panicValue{code: 42}, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	assert.PanicsWithValue(t, "mock: not a testify failure", func() {
+		_, _ = m.DoSomething("b", 2)
+	})
+	assert.True(t, ran)
+	m.AssertMethodCalled(t, m.DoSomething, "a", 1)
+	m.AssertMethodCalled(t, m.DoSomething, "b", 2)
+	assert.Equal(t, 2, len(m.CallsOf(m.DoSomething)))
+}
+
+func TestCall_PanicWithError(t *testing.T) {
+	err := errors.New("boom")
+	underlying := &underlyingFull{calls: map[string]int{}}
+	spy := NewSpyMockOf[mockedMy, my](underlying)
+	spy.OnMethod(spy.DoSomething).PanicWithError(err)
+
+	assert.PanicsWithError(t, "boom", func() {
+		_, _ = spy.DoSomething("a", 1)
+	})
+	spy.AssertMethodCalled(t, spy.DoSomething, "a", 1)
+	assert.Equal(t, 0, underlying.calls["DoSomething"])
+	// other methods are still spied...
+	_, _ = spy.DoSomethingElse("a", 1)
+	assert.Equal(t, 1, underlying.calls["DoSomethingElse"])
+}
+
+func TestCall_Panic_Nil(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	assert.PanicsWithValue(t, "mmock: DoSomething() Panic value must not be nil", func() {
+		m.OnMethod(m.DoSomething).Panic(nil)
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() PanicWithError error must not be nil", func() {
+		m.OnMethod(m.DoSomething).PanicWithError(nil)
+	})
+}
+
+func TestCall_Goexit(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).Goexit()
+
+	returned := false
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = m.DoSomething("a", 1)
+		returned = true
+	}()
+	<-done
+	assert.False(t, returned)
+	m.AssertMethodCalled(t, m.DoSomething, "a", 1)
+}
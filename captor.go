@@ -0,0 +1,140 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"sync"
+)
+
+// ArgCaptor captures the values of an arg of calls to a mocked method (see Captor)
+type ArgCaptor[T any] struct {
+	lock     sync.Mutex
+	values   []T
+	recorded map[uint64]bool // sequences of the call records already captured by assertions
+}
+
+// Captor creates a new arg captor - which can be used as an arg in OnMethod (and AssertMethodCalled) to match
+// any value of type T and capture every matched value
+//
+// Example:
+//
+//	handler := mmock.Captor[func(string)]()
+//	myMock.OnMethod(myMock.Register, "topic", handler)
+//	...
+//	handler.Last()("message")
+//
+// When used in assertions (e.g. AssertMethodCalled), each matched call is captured only once - repeating the same
+// assertion with the same captor does not capture the same calls again
+//
+// For variadic methods, a captor at (or beyond) the variadic position captures the variadic value at that position (and
+// T should be the variadic element type)
+func Captor[T any]() *ArgCaptor[T] {
+	return &ArgCaptor[T]{}
+}
+
+// Last returns the last captured value (or the zero value if nothing has been captured)
+func (c *ArgCaptor[T]) Last() T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.values) == 0 {
+		var zero T
+		return zero
+	}
+	return c.values[len(c.values)-1]
+}
+
+// All returns all the captured values (in the order they were captured)
+func (c *ArgCaptor[T]) All() []T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]T{}, c.values...)
+}
+
+// Len returns the number of captured values
+func (c *ArgCaptor[T]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.values)
+}
+
+func (c *ArgCaptor[T]) capturedType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (c *ArgCaptor[T]) matcher() any {
+	return mock.MatchedBy(func(T) bool {
+		return true
+	})
+}
+
+func (c *ArgCaptor[T]) capture(v any) {
+	var value T
+	if v != nil {
+		reflect.ValueOf(&value).Elem().Set(reflect.ValueOf(v))
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.values = append(c.values, value)
+}
+
+func (c *ArgCaptor[T]) captureRecord(sequence uint64, v any) {
+	c.lock.Lock()
+	if c.recorded[sequence] {
+		c.lock.Unlock()
+		return
+	}
+	if c.recorded == nil {
+		c.recorded = map[uint64]bool{}
+	}
+	c.recorded[sequence] = true
+	c.lock.Unlock()
+	c.capture(v)
+}
+
+// captor is the non-generic interface of all ArgCaptor types
+type captor interface {
+	capturedType() reflect.Type
+	matcher() any
+	capture(v any)
+	captureRecord(sequence uint64, v any)
+}
+
+// argCaptor is a captor at an arg position
+type argCaptor struct {
+	index  int
+	captor captor
+}
+
+// replaceCaptors replaces any captors in the args with matchers (returning the replaced args and the captor positions)
+func replaceCaptors(arguments []any) ([]any, []argCaptor) {
+	var captors []argCaptor
+	for i, arg := range arguments {
+		if c, ok := arg.(captor); ok {
+			if captors == nil {
+				arguments = append([]any{}, arguments...)
+			}
+			captors = append(captors, argCaptor{index: i, captor: c})
+			arguments[i] = c.matcher()
+		}
+	}
+	return arguments, captors
+}
+
+func captureArguments(captors []argCaptor, arguments []any) {
+	for _, c := range captors {
+		if c.index < len(arguments) {
+			c.captor.capture(arguments[c.index])
+		}
+	}
+}
+
+// captureRecords captures the args of call records (as matched by assertions) - each record is captured only once by a captor
+func captureRecords(captors []argCaptor, records ...CallRecord) {
+	for _, record := range records {
+		for _, c := range captors {
+			if c.index < len(record.Arguments) {
+				c.captor.captureRecord(record.Sequence, record.Arguments[c.index])
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCaptor(t *testing.T) {
+	m := NewMock[captorMock]()
+	handler := Captor[func(string)]()
+	topic := Captor[string]()
+	m.OnMethod(m.Register, topic, handler)
+
+	received := make([]string, 0)
+	m.Register("a", func(msg string) {
+		received = append(received, "a:"+msg)
+	})
+	m.Register("b", func(msg string) {
+		received = append(received, "b:"+msg)
+	})
+	assert.Equal(t, 2, handler.Len())
+	assert.Equal(t, []string{"a", "b"}, topic.All())
+	assert.Equal(t, "b", topic.Last())
+	handler.Last()("x")
+	handler.All()[0]("y")
+	assert.Equal(t, []string{"b:x", "a:y"}, received)
+}
+
+func TestCaptor_OnlyCapturesMatchedCalls(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	value := Captor[*diagnosticStruct]()
+	m.OnMethod(m.Save, "a", mock.Anything, value).Return(nil)
+	m.OnMethod(m.Save, "b").Return(nil)
+	_ = m.Save("a", 1, &diagnosticStruct{Name: "x"})
+	_ = m.Save("b", 1, &diagnosticStruct{Name: "y"})
+	_ = m.Save("a", 1, nil)
+	assert.Equal(t, []*diagnosticStruct{{Name: "x"}, nil}, value.All())
+	m.AssertMethodCalled(t, m.Save, "a", mock.Anything, Captor[*diagnosticStruct]())
+}
+
+func TestCaptor_Empty(t *testing.T) {
+	c := Captor[int]()
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 0, c.Last())
+	assert.Equal(t, []int{}, c.All())
+}
+
+func TestCaptor_WithRun(t *testing.T) {
+	m := NewMock[captorMock]()
+	topic := Captor[string]()
+	ran := false
+	m.OnMethod(m.Register, topic).Run(func(args mock.Arguments) {
+		ran = true
+	})
+	m.Register("a", nil)
+	assert.True(t, ran)
+	assert.Equal(t, "a", topic.Last())
+}
+
+func TestCaptor_Variadic(t *testing.T) {
+	m := NewMock[variadicMock]()
+	first := Captor[int]()
+	second := Captor[int]()
+	m.OnMethod(m.Sum, "a", first, second).Return(3)
+	assert.Equal(t, 3, m.Sum("a", 1, 2))
+	assert.Equal(t, 1, first.Last())
+	assert.Equal(t, 2, second.Last())
+	assert.Panics(t, func() {
+		// captors only match a single variadic value
+		m.Sum("a", 1, 2, 3)
+	})
+
+	assert.PanicsWithValue(t, "mmock: Sum() arg [1]: captor of type string cannot capture type int", func() {
+		m.OnMethod(m.Sum, "a", Captor[string]())
+	})
+}
+
+func TestCaptor_AssertMethodCalled(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+	key := Captor[string]()
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, key))
+	assert.Equal(t, []string{"a", "b"}, key.All())
+	m.AssertMethodNotCalled(t, m.Save, Captor[string]())
+}
+
+func TestCaptor_AssertRepeated(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Return(nil)
+	_ = m.Delete("a")
+	_ = m.Delete("b")
+	key := Captor[string]()
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, key))
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, key))
+	assert.True(t, m.AssertNthMethodCall(t, m.Delete, 2, key))
+	assert.Equal(t, []string{"a", "b"}, key.All())
+	_ = m.Delete("c")
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, key))
+	assert.Equal(t, []string{"a", "b", "c"}, key.All())
+	other := Captor[string]()
+	assert.True(t, m.AssertMethodCalled(t, m.Delete, other))
+	assert.Equal(t, []string{"a", "b", "c"}, other.All())
+}
+
+func TestCaptor_Typed(t *testing.T) {
+	m := NewMock[captorMock]()
+	handler := Captor[func(string)]()
+	On2R0(m, m.Register).With("a", handler)
+	called := false
+	m.Register("a", func(string) {
+		called = true
+	})
+	handler.Last()("x")
+	assert.True(t, called)
+}
+
+func TestCaptor_Interface(t *testing.T) {
+	m := NewMock[captorMock]()
+	st := Captor[fmtStringer]()
+	m.OnMethod(m.Print, st)
+	m.Print(captorStringer("a"))
+	m.Print(nil)
+	assert.Equal(t, []fmtStringer{captorStringer("a"), nil}, st.All())
+}
+
+type fmtStringer interface {
+	String() string
+}
+
+type captorStringer string
+
+func (s captorStringer) String() string {
+	return string(s)
+}
+
+type captorMock struct {
+	MockMethods
+}
+
+func (m *captorMock) Register(topic string, handler func(string)) {
+	m.Called(topic, handler)
+}
+
+func (m *captorMock) Print(s fmtStringer) {
+	m.Called(s)
+}
@@ -0,0 +1,239 @@
+package mmock
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Chaos perturbs the calls that a spy mock passes through to its wrapped implementation (see MockMethods.SetChaos) - by
+// injecting latency, errors and context cancellation
+//
+// Example:
+//
+//	spy.SetChaos(mmock.NewChaos(42).Latency(10*time.Millisecond, 5*time.Millisecond).Errors(0.1, errors.New("fail")))
+//
+// The perturbations are determined by the seed - so that a run (with the same sequence of calls) can be replayed
+type Chaos struct {
+	seed            int64
+	lock            sync.Mutex
+	rnd             *rand.Rand
+	latency         chaosLatency
+	methodLatencies map[string]chaosLatency
+	errRate         float64
+	err             error
+	cancelBefore    float64
+	cancelAfter     float64
+	perturbations   []string
+}
+
+type chaosLatency struct {
+	latency time.Duration
+	jitter  time.Duration
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// NewChaos creates a new Chaos with the specified seed (if the seed is 0, a seed is generated)
+func NewChaos(seed int64) *Chaos {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Chaos{
+		seed:            seed,
+		rnd:             rand.New(rand.NewSource(seed)),
+		methodLatencies: map[string]chaosLatency{},
+	}
+}
+
+// Latency sets the latency injected before every call of the wrapped implementation - the latency plus a random
+// jitter (of up to the specified jitter)
+//
+// If the call has a context.Context arg, the latency ends early when the context is done (the latency uses the mock's
+// clock - see MockMethods.SetClock)
+func (c *Chaos) Latency(latency time.Duration, jitter time.Duration) *Chaos {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.latency = chaosLatency{latency: latency, jitter: jitter}
+	return c
+}
+
+// MethodLatency sets the latency injected before calls of a specific method (overriding Latency for that method)
+//
+// The method can be specified by func pointer or name
+func (c *Chaos) MethodLatency(method any, latency time.Duration, jitter time.Duration) *Chaos {
+	methodName, ok := method.(string)
+	if fv := reflect.ValueOf(method); !ok && fv.Kind() == reflect.Func {
+		methodName = parseMethodName(runtime.FuncForPC(fv.Pointer()).Name())
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.methodLatencies[methodName] = chaosLatency{latency: latency, jitter: jitter}
+	return c
+}
+
+// Errors sets the rate (e.g. 0.1 for approximately 1 in 10 calls) at which the error result of the wrapped implementation
+// is replaced with the specified error
+func (c *Chaos) Errors(rate float64, err error) *Chaos {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.errRate, c.err = rate, err
+	return c
+}
+
+// CancelBefore sets the rate at which the context.Context arg of a call is cancelled before the wrapped implementation is called
+func (c *Chaos) CancelBefore(rate float64) *Chaos {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cancelBefore = rate
+	return c
+}
+
+// CancelAfter sets the rate at which the context.Context arg of a call is cancelled after the wrapped implementation returns
+// (e.g. to perturb work the implementation continues in the background)
+func (c *Chaos) CancelAfter(rate float64) *Chaos {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cancelAfter = rate
+	return c
+}
+
+// Seed returns the seed of the chaos
+func (c *Chaos) Seed() int64 {
+	return c.seed
+}
+
+// Perturbations returns descriptions of the perturbations injected so far (e.g. "Fetch(): latency 12ms")
+func (c *Chaos) Perturbations() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string{}, c.perturbations...)
+}
+
+// String returns a description of the chaos (e.g. "Chaos(seed: 42)")
+func (c *Chaos) String() string {
+	return fmt.Sprintf("Chaos(seed: %d)", c.seed)
+}
+
+// SetChaos sets the chaos that perturbs the calls that a spy mock passes through to its wrapped implementation
+// (calls matched to expectations are not perturbed)
+//
+// The chaos (and its perturbations) are logged by AssertExpectations (or when the bound test finishes - see Test) if the test has failed
+func (mm *MockMethods) SetChaos(chaos *Chaos) {
+	mm.lock.Lock()
+	if mm.wrapped == nil {
+		mm.lock.Unlock()
+		mm.fail("mmock: SetChaos can only be used on spy mocks (use NewSpyMockOf to create the mock)")
+		return
+	}
+	mm.chaos = chaos
+	mm.lock.Unlock()
+}
+
+// chaosDecision is what chaos injects into a single call
+type chaosDecision struct {
+	latency      time.Duration
+	err          bool
+	errValue     error // the error injected (read under the lock when the decision is made)
+	cancelBefore bool
+	cancelAfter  bool
+}
+
+func (c *Chaos) decide(methodName string) (d chaosDecision) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	l, ok := c.methodLatencies[methodName]
+	if !ok {
+		l = c.latency
+	}
+	d.latency = l.latency
+	if l.jitter > 0 {
+		d.latency += time.Duration(c.rnd.Int63n(int64(l.jitter)))
+	}
+	if c.errRate > 0 {
+		d.err = c.rnd.Float64() < c.errRate
+		d.errValue = c.err
+	}
+	if c.cancelBefore > 0 {
+		d.cancelBefore = c.rnd.Float64() < c.cancelBefore
+	}
+	if c.cancelAfter > 0 {
+		d.cancelAfter = c.rnd.Float64() < c.cancelAfter
+	}
+	return
+}
+
+func (c *Chaos) addPerturbation(methodName string, format string, args ...any) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.perturbations = append(c.perturbations, methodName+"(): "+fmt.Sprintf(format, args...))
+}
+
+// call calls a method of the wrapped implementation - perturbing the call (if there is chaos)
+func (c *Chaos) call(methodName string, m reflect.Value, args []reflect.Value, clock Clock) []reflect.Value {
+	if c == nil {
+		return m.Call(args)
+	}
+	d := c.decide(methodName)
+	var ctx context.Context
+	cancel := func() {}
+	if i := contextArgIndex(m.Type(), args); i != -1 && (d.cancelBefore || d.cancelAfter) {
+		ctx, cancel = context.WithCancel(args[i].Interface().(context.Context))
+		args[i] = reflect.ValueOf(ctx)
+	} else if i != -1 {
+		ctx = args[i].Interface().(context.Context)
+	}
+	defer cancel()
+	if d.latency > 0 {
+		c.addPerturbation(methodName, "latency %s", d.latency)
+		sleep(clock, ctx, d.latency)
+	}
+	if d.cancelBefore && ctx != nil {
+		c.addPerturbation(methodName, "context cancelled before call")
+		cancel()
+	}
+	results := m.Call(args)
+	if d.cancelAfter && ctx != nil {
+		c.addPerturbation(methodName, "context cancelled after call")
+		cancel()
+	}
+	if i := errorResultIndex(m.Type()); d.err && i != -1 {
+		c.addPerturbation(methodName, "error %v", d.errValue)
+		results[i] = reflect.ValueOf(&d.errValue).Elem()
+	}
+	return results
+}
+
+// report describes the chaos and the perturbations injected
+func (c *Chaos) report() string {
+	perturbations := c.Perturbations()
+	if len(perturbations) == 0 {
+		return c.String() + " - no perturbations"
+	}
+	return c.String() + " - perturbations:\n\t" + strings.Join(perturbations, "\n\t")
+}
+
+// contextArgIndex returns the index of the first context.Context arg of a method (or -1 if there is none)
+func contextArgIndex(mt reflect.Type, args []reflect.Value) int {
+	for i, arg := range args {
+		if i < mt.NumIn() && mt.In(i) == contextType && arg.IsValid() && arg.Type().Implements(contextType) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sleep sleeps (on the clock) for the duration - or until the context (if any) is done
+func sleep(clock Clock, ctx context.Context, d time.Duration) {
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+	case <-done(ctx):
+	}
+}
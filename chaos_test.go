@@ -0,0 +1,159 @@
+package mmock
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type fetcher interface {
+	Fetch(ctx context.Context, id string) (string, error)
+	Ping() error
+}
+
+type fetcherMock struct {
+	MockMethods
+}
+
+func (m *fetcherMock) Fetch(ctx context.Context, id string) (string, error) {
+	args := m.Called(ctx, id)
+	return As[string](args, 0), As[error](args, 1)
+}
+
+func (m *fetcherMock) Ping() error {
+	return As1[error](m.Called())
+}
+
+type realFetcher struct{}
+
+func (f *realFetcher) Fetch(ctx context.Context, id string) (string, error) {
+	return "fetched " + id, ctx.Err()
+}
+
+func (f *realFetcher) Ping() error {
+	return nil
+}
+
+func TestChaos_Latency(t *testing.T) {
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	chaos := NewChaos(42).Latency(5*time.Millisecond, 0).MethodLatency(spy.Ping, 20*time.Millisecond, 5*time.Millisecond)
+	spy.SetChaos(chaos)
+
+	start := time.Now()
+	r, err := spy.Fetch(context.Background(), "a")
+	require.NoError(t, err)
+	assert.Equal(t, "fetched a", r)
+	assert.True(t, time.Since(start) >= 5*time.Millisecond)
+
+	start = time.Now()
+	require.NoError(t, spy.Ping())
+	assert.True(t, time.Since(start) >= 20*time.Millisecond)
+
+	perturbations := chaos.Perturbations()
+	require.Equal(t, 2, len(perturbations))
+	assert.Equal(t, "Fetch(): latency 5ms", perturbations[0])
+	assert.Contains(t, perturbations[1], "Ping(): latency ")
+	spy.AssertMethodCalled(t, spy.Ping)
+}
+
+func TestChaos_LatencyEndsWithContext(t *testing.T) {
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	spy.SetChaos(NewChaos(42).Latency(time.Minute, 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := spy.Fetch(ctx, "a")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestChaos_Errors(t *testing.T) {
+	errChaos := errors.New("chaos")
+	results := func(seed int64) []error {
+		spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+		spy.SetChaos(NewChaos(seed).Errors(0.5, errChaos))
+		result := make([]error, 20)
+		for i := range result {
+			result[i] = spy.Ping()
+		}
+		calls := spy.CallsOf(spy.Ping)
+		require.Equal(t, 20, len(calls))
+		for i, c := range calls {
+			assert.Equal(t, result[i], As[error](c.Returns, 0))
+		}
+		return result
+	}
+	first := results(42)
+	assert.Equal(t, first, results(42))
+	assert.Contains(t, first, errChaos)
+	assert.Contains(t, first, nil)
+}
+
+func TestChaos_ConcurrentReconfigure(t *testing.T) {
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	chaos := NewChaos(42).Errors(1, errors.New("first"))
+	spy.SetChaos(chaos)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			chaos.Errors(1, errors.New("second"))
+			spy.SetSpyOf(&realFetcher{})
+			spy.SetChaos(chaos)
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		assert.Error(t, spy.Ping())
+	}
+	<-done
+	assert.EqualError(t, spy.Ping(), "second")
+}
+
+func TestChaos_Cancel(t *testing.T) {
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	chaos := NewChaos(42).CancelBefore(1)
+	spy.SetChaos(chaos)
+	_, err := spy.Fetch(context.Background(), "a")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"Fetch(): context cancelled before call"}, chaos.Perturbations())
+
+	spy = NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	chaos = NewChaos(42).CancelAfter(1)
+	spy.SetChaos(chaos)
+	_, err = spy.Fetch(context.Background(), "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Fetch(): context cancelled after call"}, chaos.Perturbations())
+	// no context arg to cancel...
+	assert.NoError(t, spy.Ping())
+	assert.Equal(t, 1, len(chaos.Perturbations()))
+}
+
+func TestChaos_ExpectedCallsNotPerturbed(t *testing.T) {
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	chaos := NewChaos(42).Errors(1, errors.New("chaos"))
+	spy.SetChaos(chaos)
+	spy.OnMethod(spy.Ping).Return(nil)
+	assert.NoError(t, spy.Ping())
+	assert.Equal(t, 0, len(chaos.Perturbations()))
+}
+
+func TestMockMethods_SetChaos_NotSpy(t *testing.T) {
+	m := NewMock[fetcherMock]()
+	assert.PanicsWithValue(t, "mmock: SetChaos can only be used on spy mocks (use NewSpyMockOf to create the mock)", func() {
+		m.SetChaos(NewChaos(42))
+	})
+	assert.NotEqual(t, int64(0), NewChaos(0).Seed())
+}
+
+func TestChaos_ReportedOnFailure(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	spy := NewSpyMockT[fetcherMock, fetcher](ft, &realFetcher{})
+	spy.SetChaos(NewChaos(42).Errors(1, errors.New("chaos")))
+	spy.OnMethod(spy.Fetch).Return("", nil)
+	_ = spy.Ping()
+	ft.cleanup()
+	assert.True(t, ft.failed)
+	assert.Contains(t, ft.logs, "mmock: chaos was injected by Chaos(seed: 42) - perturbations:\n\tPing(): error chaos")
+}
@@ -0,0 +1,345 @@
+package mmock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the time source used for mock delays (see MockMethods.SetClock) - and can be used by code under test so that
+// it can be given a FakeClock (or a ClockMock) in tests
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// Since returns the time elapsed since t
+	Since(t time.Time) time.Duration
+	// After waits for the duration to elapse and then sends the current time on the returned channel
+	After(d time.Duration) <-chan time.Time
+	// Sleep pauses for the duration
+	Sleep(d time.Duration)
+	// NewTimer creates a new Timer that sends the current time on its channel after the duration
+	NewTimer(d time.Duration) Timer
+	// NewTicker creates a new Ticker that sends the current time on its channel every period
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is the Clock equivalent of time.Timer
+type Timer interface {
+	// C returns the channel on which the time is sent
+	C() <-chan time.Time
+	// Stop prevents the timer from firing - returning false if the timer has already fired or been stopped
+	Stop() bool
+	// Reset changes the timer to fire after the duration - returning true if the timer had been active
+	Reset(d time.Duration) bool
+}
+
+// Ticker is the Clock equivalent of time.Ticker
+type Ticker interface {
+	// C returns the channel on which the ticks are sent
+	C() <-chan time.Time
+	// Stop turns off the ticker
+	Stop()
+	// Reset stops the ticker and resets its period to the duration
+	Reset(d time.Duration)
+}
+
+// RealClock returns a Clock that uses the time package (the default clock of mocks)
+func RealClock() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// SetClock sets the clock used by the mock's delays (After, BlockFor and the latency of Chaos) and the times of call records
+//
+// Using a FakeClock means that advancing the fake clock releases blocked mock calls - without real sleeping
+func (mm *MockMethods) SetClock(clock Clock) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.clock = clock
+}
+
+// getClock returns the clock of the mock (the real clock if none has been set)
+func (mm *MockMethods) getClock() Clock {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if mm.clock == nil {
+		return realClock{}
+	}
+	return mm.clock
+}
+
+// FakeClock is a Clock whose time only changes when it is advanced (see FakeClock.Advance) - timers, tickers, After and
+// Sleep are fired when the clock is advanced to (or past) their deadline
+type FakeClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	changed chan struct{}
+}
+
+// NewFakeClock creates a new FakeClock with the specified current time
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{
+		now:     now,
+		changed: make(chan struct{}),
+	}
+}
+
+// Now returns the current time of the fake clock
+func (c *FakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+// Since returns the time elapsed (on the fake clock) since t
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// After returns a channel on which the time is sent when the fake clock is advanced by the duration
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// Sleep blocks until the fake clock is advanced by the duration
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// NewTimer creates a new Timer that fires when the fake clock is advanced by the duration
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	t.Reset(d)
+	return t
+}
+
+// NewTicker creates a new Ticker that ticks each time the fake clock is advanced by the period
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock.NewTicker")
+	}
+	t := &fakeTicker{fakeTimer{clock: c, c: make(chan time.Time, 1)}}
+	t.Reset(d)
+	return t
+}
+
+// Advance advances the fake clock by the duration - firing any timers (and tickers etc.) whose deadline is reached
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set sets the current time of the fake clock - firing any timers (and tickers etc.) whose deadline is reached
+//
+// Timers are fired in deadline order (with the current time of the clock at each timer's deadline)
+func (c *FakeClock) Set(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for {
+		sort.SliceStable(c.timers, func(i, j int) bool {
+			return c.timers[i].deadline.Before(c.timers[j].deadline)
+		})
+		if len(c.timers) == 0 || c.timers[0].deadline.After(now) {
+			break
+		}
+		t := c.timers[0]
+		if t.deadline.After(c.now) {
+			c.now = t.deadline
+		}
+		select {
+		case t.c <- c.now:
+		default:
+			// dropped (as with time.Ticker)...
+		}
+		if t.period > 0 {
+			t.deadline = t.deadline.Add(t.period)
+		} else {
+			c.removeTimer(t)
+		}
+	}
+	if now.After(c.now) {
+		c.now = now
+	}
+	c.notify()
+}
+
+// Waiters returns the number of active timers (including After, Sleep and tickers) waiting on the fake clock
+func (c *FakeClock) Waiters() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.timers)
+}
+
+// AwaitWaiters waits until at least n timers (including After, Sleep and tickers) are waiting on the fake clock - returning
+// false if the (real) timeout elapses first
+//
+// Useful to make sure that a mock call is blocked on the fake clock before advancing it
+func (c *FakeClock) AwaitWaiters(n int, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		c.lock.Lock()
+		waiters, changed := len(c.timers), c.changed
+		c.lock.Unlock()
+		if waiters >= n {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+// notify notifies a change of timers - must be called with the lock held
+func (c *FakeClock) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// removeTimer removes a timer - returning whether it was active (must be called with the lock held)
+func (c *FakeClock) removeTimer(t *fakeTimer) bool {
+	for i, et := range c.timers {
+		if et == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	c        chan time.Time
+	deadline time.Time
+	period   time.Duration
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+	active := t.clock.removeTimer(t)
+	t.clock.notify()
+	return active
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	return t.reset(d, 0)
+}
+
+func (t *fakeTimer) reset(d time.Duration, period time.Duration) bool {
+	c := t.clock
+	c.lock.Lock()
+	active := c.removeTimer(t)
+	t.deadline, t.period = c.now.Add(d), period
+	if d <= 0 && period == 0 {
+		select {
+		case t.c <- c.now:
+		default:
+		}
+	} else {
+		c.timers = append(c.timers, t)
+	}
+	c.notify()
+	c.lock.Unlock()
+	return active
+}
+
+type fakeTicker struct {
+	fakeTimer
+}
+
+func (t *fakeTicker) Stop() {
+	t.fakeTimer.Stop()
+}
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock Ticker.Reset")
+	}
+	t.fakeTimer.reset(d, d)
+}
+
+// ClockMock is a ready-made mock of Clock - for code under test that uses a Clock, e.g.
+//
+//	clk := mmock.NewMock[mmock.ClockMock]()
+//	clk.OnMethod(clk.Now).Return(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+type ClockMock struct {
+	MockMethods
+}
+
+// Now is the mocked Clock.Now
+func (m *ClockMock) Now() time.Time {
+	return As1[time.Time](m.Called())
+}
+
+// Since is the mocked Clock.Since
+func (m *ClockMock) Since(t time.Time) time.Duration {
+	return As1[time.Duration](m.Called(t))
+}
+
+// After is the mocked Clock.After
+func (m *ClockMock) After(d time.Duration) <-chan time.Time {
+	return As1[<-chan time.Time](m.Called(d))
+}
+
+// Sleep is the mocked Clock.Sleep
+func (m *ClockMock) Sleep(d time.Duration) {
+	m.Called(d)
+}
+
+// NewTimer is the mocked Clock.NewTimer
+func (m *ClockMock) NewTimer(d time.Duration) Timer {
+	return As1[Timer](m.Called(d))
+}
+
+// NewTicker is the mocked Clock.NewTicker
+func (m *ClockMock) NewTicker(d time.Duration) Ticker {
+	return As1[Ticker](m.Called(d))
+}
@@ -0,0 +1,166 @@
+package mmock
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var clockStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFakeClock_Timers(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	assert.Equal(t, clockStart, clock.Now())
+
+	after := clock.After(time.Second)
+	timer := clock.NewTimer(2 * time.Second)
+	stopped := clock.NewTimer(time.Second)
+	assert.True(t, stopped.Stop())
+	assert.False(t, stopped.Stop())
+	assert.Equal(t, 2, clock.Waiters())
+
+	clock.Advance(500 * time.Millisecond)
+	assertNotFired(t, after)
+	clock.Advance(500 * time.Millisecond)
+	assert.Equal(t, clockStart.Add(time.Second), <-after)
+	assertNotFired(t, timer.C())
+	assertNotFired(t, stopped.C())
+	assert.Equal(t, time.Second, clock.Since(clockStart))
+
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, clockStart.Add(2*time.Second), <-timer.C())
+	assert.Equal(t, clockStart.Add(6*time.Second), clock.Now())
+	assert.False(t, timer.Reset(time.Second))
+	clock.Advance(time.Second)
+	assert.Equal(t, clockStart.Add(7*time.Second), <-timer.C())
+	assert.Equal(t, 0, clock.Waiters())
+
+	select {
+	case <-clock.After(0):
+	default:
+		assert.Fail(t, "zero duration should fire immediately")
+	}
+}
+
+func TestFakeClock_Ticker(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	ticker := clock.NewTicker(time.Second)
+
+	clock.Advance(time.Second)
+	assert.Equal(t, clockStart.Add(time.Second), <-ticker.C())
+	clock.Advance(3 * time.Second)
+	// ticks are dropped when not received...
+	assert.Equal(t, clockStart.Add(2*time.Second), <-ticker.C())
+	assertNotFired(t, ticker.C())
+	ticker.Reset(2 * time.Second)
+	clock.Advance(time.Second)
+	assertNotFired(t, ticker.C())
+	clock.Advance(time.Second)
+	assert.Equal(t, clockStart.Add(6*time.Second), <-ticker.C())
+	ticker.Stop()
+	clock.Advance(time.Minute)
+	assertNotFired(t, ticker.C())
+
+	assert.Panics(t, func() {
+		clock.NewTicker(0)
+	})
+}
+
+func TestFakeClock_Sleep(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	done := make(chan struct{})
+	go func() {
+		clock.Sleep(time.Hour)
+		close(done)
+	}()
+	require.True(t, clock.AwaitWaiters(1, time.Second))
+	clock.Advance(time.Hour)
+	<-done
+	assert.False(t, clock.AwaitWaiters(1, time.Millisecond))
+}
+
+func TestMockMethods_SetClock_After(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	m := NewMock[fetcherMock]()
+	m.SetClock(clock)
+	m.OnMethod(m.Fetch).After(time.Hour).Return("data", nil)
+
+	results := make(chan string, 1)
+	go func() {
+		r, _ := m.Fetch(context.Background(), "a")
+		results <- r
+	}()
+	require.True(t, clock.AwaitWaiters(1, time.Second))
+	assertNotFired(t, results)
+	clock.Advance(time.Hour)
+	assert.Equal(t, "data", <-results)
+}
+
+func TestMockMethods_SetClock_BlockFor(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	m := NewMock[fetcherMock]()
+	m.SetClock(clock)
+	m.OnMethod(m.Fetch).BlockFor(time.Minute).Return("data", nil)
+
+	results := make(chan error, 1)
+	go func() {
+		_, err := m.Fetch(context.Background(), "a")
+		results <- err
+	}()
+	require.True(t, clock.AwaitWaiters(1, time.Second))
+	clock.Advance(time.Minute)
+	assert.NoError(t, <-results)
+	assert.Equal(t, 0, clock.Waiters())
+}
+
+func TestMockMethods_SetClock_ChaosLatency(t *testing.T) {
+	clock := NewFakeClock(clockStart)
+	spy := NewSpyMockOf[fetcherMock, fetcher](&realFetcher{})
+	spy.SetClock(clock)
+	spy.SetChaos(NewChaos(42).Latency(time.Hour, 0))
+
+	results := make(chan error, 1)
+	go func() {
+		results <- spy.Ping()
+	}()
+	require.True(t, clock.AwaitWaiters(1, time.Second))
+	clock.Advance(time.Hour)
+	assert.NoError(t, <-results)
+}
+
+func TestRealClock(t *testing.T) {
+	clock := RealClock()
+	start := clock.Now()
+	clock.Sleep(time.Millisecond)
+	assert.True(t, clock.Since(start) >= time.Millisecond)
+	<-clock.After(time.Millisecond)
+	timer := clock.NewTimer(time.Millisecond)
+	<-timer.C()
+	ticker := clock.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Stop()
+}
+
+func TestClockMock(t *testing.T) {
+	var _ Clock = &ClockMock{}
+	clk := NewMock[ClockMock]()
+	clk.OnMethod(clk.Now).Return(clockStart)
+	clk.OnMethod(clk.Sleep)
+	clk.OnMethod(clk.NewTimer).Return(NewFakeClock(clockStart).NewTimer(time.Second))
+	var clock Clock = clk
+	assert.Equal(t, clockStart, clock.Now())
+	clock.Sleep(time.Hour)
+	assert.NotNil(t, clock.NewTimer(time.Second))
+	clk.AssertMethodCalled(t, clk.Sleep, time.Hour)
+}
+
+func assertNotFired[T any](t *testing.T, c <-chan T) {
+	t.Helper()
+	select {
+	case v := <-c:
+		assert.Fail(t, "channel should not have fired", "got %v", v)
+	default:
+	}
+}
@@ -0,0 +1,100 @@
+// Command mmock provides tools for migrating tests to mmock
+//
+// Usage:
+//
+//	mmock migrate [-n] [packages]
+//	mmock mockery [-n] [-interface path.Name] files
+//
+// The migrate command rewrites string named testify mock methods (e.g. m.On("DoSomething")) to
+// mmock method values (e.g. m.OnMethod(m.DoSomething)) and replaces embedded mock.Mock with mmock.MockMethods
+//
+// The mockery command converts mockery generated mock files into mmock mocks (retaining the generated
+// NewMockXxx(t) constructors and EXPECT() helpers) - use -interface to specify the mocked interface type
+// (e.g. -interface example.com/stuff.Thing) so that the mock constructors check the mock implements it
+//
+// Use -n for a dry-run - which prints a diff of the changes rather than writing them
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/go-andiamo/mmock/migrate"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "migrate":
+		err = runMigrate(os.Args[2:])
+	case "mockery":
+		err = runMockery(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: mmock migrate [-n] [packages]")
+	fmt.Fprintln(os.Stderr, "       mmock mockery [-n] [-interface path.Name] files")
+	os.Exit(2)
+}
+
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("n", false, "dry-run - print a diff of the changes without writing them")
+	_ = fs.Parse(args)
+	result, err := migrate.Migrate(fs.Args()...)
+	if err != nil {
+		return err
+	}
+	for _, w := range result.Warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+	for _, change := range result.Changes {
+		if err = applyChange(change, *dryRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runMockery(args []string) error {
+	fs := flag.NewFlagSet("mockery", flag.ExitOnError)
+	dryRun := fs.Bool("n", false, "dry-run - print a diff of the changes without writing them")
+	intf := fs.String("interface", "", "the mocked interface type (e.g. example.com/stuff.Thing)")
+	_ = fs.Parse(args)
+	for _, filename := range fs.Args() {
+		change, warnings, err := migrate.ConvertMockery(filename, migrate.MockeryOptions{Interface: *intf})
+		if err != nil {
+			return err
+		}
+		for _, w := range warnings {
+			fmt.Fprintln(os.Stderr, w)
+		}
+		if change == nil {
+			fmt.Fprintf(os.Stderr, "%s: no mockery mocks found\n", filename)
+		} else if err = applyChange(*change, *dryRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyChange(change migrate.FileChange, dryRun bool) error {
+	if dryRun {
+		fmt.Print(change.Diff())
+		return nil
+	} else if err := change.Write(); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d rewrites\n", change.Filename, change.Rewrites)
+	return nil
+}
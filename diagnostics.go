@@ -0,0 +1,244 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerSource returns the source location of the first caller outside of this package
+func callerSource() string {
+	if callers := callerFrames(1); len(callers) > 0 {
+		return callers[0]
+	}
+	return "unknown"
+}
+
+// callSite returns the source location of the call of a mocked method (i.e. the caller of the mock's method)
+func callSite() string {
+	if callers := callerFrames(2); len(callers) > 1 {
+		return callers[1]
+	}
+	return "unknown"
+}
+
+// callerFrames returns the source locations of (up to limit) callers outside of this package
+func callerFrames(limit int) []string {
+	result := make([]string, 0, limit)
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for more := true; more && len(result) < limit; {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		if strings.HasPrefix(frame.Function, "runtime.") || strings.HasPrefix(frame.Function, "github.com/stretchr/testify/") {
+			continue
+		} else if filepath.Dir(frame.File) != packageDir || strings.HasSuffix(frame.File, "_test.go") {
+			result = append(result, fmt.Sprintf("%s:%d", frame.File, frame.Line))
+		}
+	}
+	return result
+}
+
+// unmatchedCallReport describes a call that did not match any expectation - showing, for each expectation of the method,
+// which args matched (and a field level diff of those that did not) and where the expectation was registered
+func (mm *MockMethods) unmatchedCallReport(methodName string, msg string, arguments []any) string {
+	var sb strings.Builder
+	if strings.Contains(msg, "has been called over") {
+		sb.WriteString("mmock: call made more times than expected: ")
+	} else {
+		sb.WriteString("mmock: unexpected call: ")
+	}
+	sb.WriteString(callString(methodName, arguments))
+	sb.WriteString("\n\tat: " + callSite())
+	candidates := 0
+	for _, e := range mm.Expectations() {
+		if e.Method == methodName {
+			candidates++
+			sb.WriteString(fmt.Sprintf("\n\t[%d] %s", candidates, e.String()))
+			if e.Times > 0 && e.Calls >= e.Times {
+				sb.WriteString(" (expected calls already made)")
+			}
+			writeArgumentsTable(&sb, e.Arguments, arguments)
+		}
+	}
+	if candidates == 0 {
+		sb.WriteString(fmt.Sprintf("\n\tthere are no expectations of %s()", methodName))
+	}
+	return sb.String()
+}
+
+// notCalledReport describes an asserted call that was not made - showing, for each actual call of the method,
+// which args matched (and a field level diff of those that did not)
+func (mm *MockMethods) notCalledReport(methodName string, arguments []any) string {
+	var sb strings.Builder
+	sb.WriteString("mmock: expected call was not made: " + callString(methodName, arguments))
+	candidates := 0
+	for _, record := range mm.callsOf(methodName) {
+		candidates++
+		sb.WriteString(fmt.Sprintf("\n\t[%d] actual call %s", candidates, callString(methodName, record.Arguments)))
+		writeArgumentsTable(&sb, arguments, record.Arguments)
+	}
+	if candidates == 0 {
+		sb.WriteString(fmt.Sprintf("\n\tthere were no calls of %s()", methodName))
+	}
+	return sb.String()
+}
+
+func writeArgumentsTable(sb *strings.Builder, expected []any, actual []any) {
+	l := len(expected)
+	if len(actual) > l {
+		l = len(actual)
+	}
+	for i := 0; i < l; i++ {
+		if i >= len(expected) {
+			sb.WriteString(fmt.Sprintf("\n\t\targ [%d]: MISMATCH - unexpected extra arg %s", i, formatArg(actual[i])))
+		} else if i >= len(actual) {
+			sb.WriteString(fmt.Sprintf("\n\t\targ [%d]: MISMATCH - missing arg, expected %s", i, formatArg(expected[i])))
+		} else if _, n := (mock.Arguments{expected[i]}).Diff([]any{actual[i]}); n == 0 {
+			sb.WriteString(fmt.Sprintf("\n\t\targ [%d]: ok", i))
+		} else if isArgumentMatcher(expected[i]) {
+			sb.WriteString(fmt.Sprintf("\n\t\targ [%d]: MISMATCH - %s did not match %s", i, formatArg(expected[i]), formatArg(actual[i])))
+		} else {
+			sb.WriteString(fmt.Sprintf("\n\t\targ [%d]: MISMATCH", i))
+			for _, d := range diffValues(expected[i], actual[i]) {
+				sb.WriteString("\n\t\t\t" + d)
+			}
+		}
+	}
+}
+
+func callString(methodName string, arguments []any) string {
+	args := make([]string, len(arguments))
+	for i, arg := range arguments {
+		args[i] = formatArg(arg)
+	}
+	return methodName + "(" + strings.Join(args, ", ") + ")"
+}
+
+func formatArg(arg any) string {
+	if arg == mock.Anything {
+		return arg.(string)
+	} else if isArgumentMatcher(arg) {
+		return fmt.Sprintf("%v", arg)
+	}
+	return formatValue(reflect.ValueOf(arg))
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	} else if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v.String())
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// diffValues describes the differences between an expected and actual value - field by field (or element by element)
+func diffValues(expected any, actual any) []string {
+	d := &differ{}
+	d.diff("", reflect.ValueOf(expected), reflect.ValueOf(actual), 0)
+	return d.diffs
+}
+
+type differ struct {
+	diffs []string
+}
+
+const maxDiffDepth = 16
+
+func (d *differ) add(path string, format string, args ...any) {
+	if path == "" {
+		path = "value"
+	}
+	d.diffs = append(d.diffs, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (d *differ) leaf(path string, e, a reflect.Value) {
+	d.add(path, "expected %s but got %s", formatValue(e), formatValue(a))
+}
+
+func (d *differ) diff(path string, e, a reflect.Value, depth int) {
+	if !e.IsValid() || !a.IsValid() {
+		if e.IsValid() != a.IsValid() {
+			d.leaf(path, e, a)
+		}
+		return
+	} else if e.Type() != a.Type() {
+		d.add(path, "expected %s %s but got %s %s", e.Type().String(), formatValue(e), a.Type().String(), formatValue(a))
+		return
+	} else if depth > maxDiffDepth {
+		if !valuesEqual(e, a) {
+			d.leaf(path, e, a)
+		}
+		return
+	}
+	switch e.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if e.IsNil() || a.IsNil() {
+			if e.IsNil() != a.IsNil() {
+				d.leaf(path, e, a)
+			}
+			return
+		}
+		d.diff(path, e.Elem(), a.Elem(), depth+1)
+	case reflect.Struct:
+		for i := 0; i < e.NumField(); i++ {
+			d.diff(path+"."+e.Type().Field(i).Name, e.Field(i), a.Field(i), depth+1)
+		}
+	case reflect.Slice, reflect.Array:
+		if e.Kind() == reflect.Slice && e.IsNil() != a.IsNil() {
+			d.leaf(path, e, a)
+			return
+		} else if e.Len() != a.Len() {
+			d.add(path, "expected length %d but got length %d", e.Len(), a.Len())
+		}
+		for i := 0; i < e.Len() && i < a.Len(); i++ {
+			d.diff(fmt.Sprintf("%s[%d]", path, i), e.Index(i), a.Index(i), depth+1)
+		}
+	case reflect.Map:
+		if e.IsNil() != a.IsNil() {
+			d.leaf(path, e, a)
+			return
+		}
+		keys := e.MapKeys()
+		for _, k := range a.MapKeys() {
+			if !e.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return formatValue(keys[i]) < formatValue(keys[j])
+		})
+		for _, k := range keys {
+			kp := fmt.Sprintf("%s[%s]", path, formatValue(k))
+			ev, av := e.MapIndex(k), a.MapIndex(k)
+			if !ev.IsValid() {
+				d.add(kp, "unexpected key (with value %s)", formatValue(av))
+			} else if !av.IsValid() {
+				d.add(kp, "missing key (expected value %s)", formatValue(ev))
+			} else {
+				d.diff(kp, ev, av, depth+1)
+			}
+		}
+	default:
+		if !valuesEqual(e, a) {
+			d.leaf(path, e, a)
+		}
+	}
+}
+
+func valuesEqual(e, a reflect.Value) bool {
+	if e.CanInterface() && a.CanInterface() {
+		return reflect.DeepEqual(e.Interface(), a.Interface())
+	}
+	return fmt.Sprintf("%#v", e) == fmt.Sprintf("%#v", a)
+}
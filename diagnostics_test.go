@@ -0,0 +1,115 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestMockMethods_UnmatchedCallReport(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[diagnosticMock, diagnosticIntf](ft)
+	m.OnMethod(m.Save, "a").Return(nil)
+	m.OnMethod(m.Save, "b", mock.AnythingOfType("int")).Return(nil)
+	m.On("Save", "c", mock.Anything, &diagnosticStruct{Name: "x", Tags: []string{"t1"}, Attrs: map[string]int{"a": 1}}).Return(nil)
+
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = m.Save("c", 1, &diagnosticStruct{Name: "y", Tags: []string{"t1", "t2"}, Attrs: map[string]int{"a": 2, "b": 1}})
+	})
+	report := ft.errors[0]
+	assert.Contains(t, report, "mmock: unexpected call: Save(\"c\", 1, &{y [t1 t2] map[a:2 b:1] <nil>})\n\tat: ")
+	assert.Contains(t, report, "diagnostics_test.go:17")
+	assert.Contains(t, report, "\n\t[1] Save(\"a\", mock.Anything, mock.Anything) registered at: ")
+	assert.Contains(t, report, "diagnostics_test.go:12\n\t\targ [0]: MISMATCH\n\t\t\tvalue: expected \"a\" but got \"c\"\n\t\targ [1]: ok\n\t\targ [2]: ok")
+	assert.Contains(t, report, "diagnostics_test.go:13\n\t\targ [0]: MISMATCH\n\t\t\tvalue: expected \"b\" but got \"c\"\n\t\targ [1]: ok\n\t\targ [2]: ok")
+	assert.Contains(t, report, "diagnostics_test.go:14\n\t\targ [0]: ok\n\t\targ [1]: ok\n\t\targ [2]: MISMATCH\n"+
+		"\t\t\t.Name: expected \"x\" but got \"y\"\n"+
+		"\t\t\t.Tags: expected length 1 but got length 2\n"+
+		"\t\t\t.Attrs[\"a\"]: expected 1 but got 2\n"+
+		"\t\t\t.Attrs[\"b\"]: unexpected key (with value 1)")
+}
+
+func TestMockMethods_UnmatchedCallReport_CalledTooManyTimes(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[diagnosticMock, diagnosticIntf](ft)
+	m.OnMethod(m.Save).Once().Return(nil)
+	_ = m.Save("a", 1, nil)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = m.Save("a", 1, nil)
+	})
+	assert.Contains(t, ft.errors[0], "mmock: call made more times than expected: Save(\"a\", 1, <nil>)")
+	assert.Contains(t, ft.errors[0], " (expected calls already made)\n\t\targ [0]: ok")
+}
+
+func TestMockMethods_UnmatchedCallReport_MatcherMismatch(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[diagnosticMock, diagnosticIntf](ft)
+	m.OnMethod(m.Save, mock.Anything, mock.MatchedBy(func(i int) bool { return i > 1 })).Return(nil)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = m.Save("a", 1, nil)
+	})
+	assert.Contains(t, ft.errors[0], "arg [1]: MISMATCH - func(int) bool did not match 1")
+}
+
+func TestMockMethods_AssertMethodCalled_Report(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Save).Return(nil)
+	_ = m.Save("a", 1, &diagnosticStruct{Name: "x"})
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertMethodCalled(ft, m.Save, "a", 2, &diagnosticStruct{Name: "y"}))
+	assert.Equal(t, "mmock: expected call was not made: Save(\"a\", 2, &{y [] map[] <nil>})\n"+
+		"\t[1] actual call Save(\"a\", 1, &{x [] map[] <nil>})\n"+
+		"\t\targ [0]: ok\n"+
+		"\t\targ [1]: MISMATCH\n"+
+		"\t\t\tvalue: expected 2 but got 1\n"+
+		"\t\targ [2]: MISMATCH\n"+
+		"\t\t\t.Name: expected \"y\" but got \"x\"", ft.errors[0])
+
+	ft = &fakeTB{TB: t}
+	assert.False(t, m.AssertMethodCalled(ft, "Delete"))
+	assert.Equal(t, "mmock: expected call was not made: Delete(mock.Anything)\n\tthere were no calls of Delete()", ft.errors[0])
+}
+
+func TestDiffValues(t *testing.T) {
+	testCases := []struct {
+		expected any
+		actual   any
+		diffs    []string
+	}{
+		{"a", "a", nil},
+		{"a", 1, []string{`value: expected string "a" but got int 1`}},
+		{nil, 1, []string{`value: expected nil but got 1`}},
+		{[]int{1, 2}, []int{1, 3}, []string{`[1]: expected 2 but got 3`}},
+		{[]int(nil), []int{}, []string{`value: expected [] but got []`}},
+		{map[string]int{"a": 1}, map[string]int{}, []string{`["a"]: missing key (expected value 1)`}},
+		{&diagnosticStruct{}, (*diagnosticStruct)(nil), []string{`value: expected &{ [] map[] <nil>} but got <nil>`}},
+		{diagnosticStruct{inner: &diagnosticStruct{Name: "a"}}, diagnosticStruct{inner: &diagnosticStruct{Name: "b"}}, []string{`.inner.Name: expected "a" but got "b"`}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.diffs, diffValues(tc.expected, tc.actual))
+	}
+}
+
+type diagnosticStruct struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+	inner *diagnosticStruct
+}
+
+type diagnosticIntf interface {
+	Save(key string, version int, value *diagnosticStruct) error
+	Delete(key string) error
+}
+
+type diagnosticMock struct {
+	MockMethods
+}
+
+func (m *diagnosticMock) Save(key string, version int, value *diagnosticStruct) error {
+	return As1[error](m.Called(key, version, value))
+}
+
+func (m *diagnosticMock) Delete(key string) error {
+	return As1[error](m.Called(key))
+}
@@ -0,0 +1,229 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"strings"
+)
+
+// Expectation describes an expected call that has been set up on a mock (see MockMethods.Expectations)
+type Expectation struct {
+	// Method is the name of the expected method
+	Method string
+	// Arguments are the expected arguments
+	Arguments mock.Arguments
+	// Name is the name given to the expectation (see Call.Named)
+	Name string
+	// Source is the source location (file:line) where the expectation was registered (empty if the expectation was
+	// registered directly with testify - e.g. by a mockery EXPECT() helper)
+	Source string
+	// Calls is the number of calls that have been matched to the expectation
+	Calls int
+	// Times is the number of calls expected (0 for any number - see Call.Once, Call.Times etc.)
+	Times int
+	// Optional is whether the expectation is optional (see Call.Maybe)
+	Optional bool
+	// Satisfied is whether the expectation has been met
+	Satisfied bool
+}
+
+// String returns a description of the expectation - including its name and where it was registered
+func (e Expectation) String() string {
+	label := ""
+	if e.Name != "" {
+		label = fmt.Sprintf("%q ", e.Name)
+	}
+	if e.Source == "" {
+		return label + callString(e.Method, e.Arguments)
+	}
+	return fmt.Sprintf("%s%s registered at: %s", label, callString(e.Method, e.Arguments), e.Source)
+}
+
+// On is the same as Mock.On() (https://pkg.go.dev/github.com/stretchr/testify/mock#Mock.On)
+//
+// Except that the source location where the expectation was registered is recorded (and reported in failures)
+//
+// Note: the returned mock.Call is testify's own - Once, Times and Maybe set on it are asserted (see AssertExpectations) but
+// are not seen by ordered calls (see InOrder), and Run set on it replaces mmock's handling of the call (so use OnMethod)
+func (mm *MockMethods) On(methodName string, arguments ...any) *mock.Call {
+	call := mm.Mock.On(methodName, arguments...)
+	return newCall(mm, call, nil, nil, callerSource()).Call
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing (see MockMethods.Expectations)
+//
+// Useful where many similar looking expectations are set up (e.g. in table driven tests)
+func (c *Call) Named(name string) *Call {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.name = name
+	return c
+}
+
+// addExpectation registers an expected call (and adds it to the ordered group - if any, see InOrder)
+func (mm *MockMethods) addExpectation(c *Call) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.expectations = append(mm.expectations, c)
+	mm.ordering.add(c)
+}
+
+// Unset is the same as mock.Call.Unset() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.Unset)
+func (c *Call) Unset() *Call {
+	c.Call.Unset()
+	c.mm.removeExpectations(c.Method, c.Arguments)
+	return c
+}
+
+// removeExpectations removes the expected calls that testify removes on Unset (i.e. those of the method whose args match)
+func (mm *MockMethods) removeExpectations(methodName string, arguments mock.Arguments) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	retained := mm.expectations[:0]
+	for _, c := range mm.expectations {
+		if c.Method == methodName {
+			if _, differences := c.Arguments.Diff(arguments); differences == 0 {
+				c.order.remove(c)
+				continue
+			}
+		}
+		retained = append(retained, c)
+	}
+	mm.expectations = retained
+}
+
+// Expectations returns a listing of the expected calls that have been set up on the mock
+//
+// The listing includes expected calls registered directly with testify (e.g. by mockery EXPECT() helpers) - but does not
+// include calls that were only recorded (e.g. unexpected calls on a spy or nice mock)
+func (mm *MockMethods) Expectations() []Expectation {
+	expected := mm.testifyExpectations()
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	calls := make(map[*mock.Call]*Call, len(mm.expectations))
+	for _, c := range mm.expectations {
+		calls[c.Call] = c
+	}
+	result := make([]Expectation, 0, len(expected))
+	for _, te := range expected {
+		if mm.recorded[te.call] {
+			continue
+		}
+		e := Expectation{
+			Method:    te.method,
+			Arguments: te.arguments,
+			Calls:     mm.countCalls(te.method, te.arguments),
+		}
+		if c, ok := calls[te.call]; ok {
+			e = c.expectation()
+		}
+		e.Satisfied = te.satisfied
+		if e.Times <= 0 {
+			// Once, Times etc. set directly on testify's call...
+			if te.repeatability > 0 {
+				e.Times = e.Calls + te.repeatability
+			} else if te.repeatability < 0 {
+				e.Times = e.Calls
+			}
+		}
+		// testify only considers an expectation that has not been called satisfied if it is optional...
+		e.Optional = e.Optional || (e.Satisfied && e.Calls == 0 && mm.countCalls(te.method, te.arguments) == 0)
+		result = append(result, e)
+	}
+	return result
+}
+
+// expectation describes the expected call (must be called with the mock locked)
+func (c *Call) expectation() Expectation {
+	return Expectation{
+		Method:    c.Method,
+		Arguments: c.Arguments,
+		Name:      c.name,
+		Source:    c.source,
+		Calls:     c.calls,
+		Times:     c.times,
+		Optional:  c.optional,
+	}
+}
+
+// countCalls counts the recorded calls that match an expected call (must be called with the mock locked)
+func (mm *MockMethods) countCalls(methodName string, arguments mock.Arguments) int {
+	count := 0
+	for _, record := range mm.records {
+		if record.Method == methodName {
+			if _, differences := arguments.Diff(record.Arguments); differences == 0 {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// testifyExpectation is an expected call registered with testify - and whether testify considers it satisfied
+type testifyExpectation struct {
+	call          *mock.Call
+	method        string
+	arguments     mock.Arguments
+	repeatability int
+	satisfied     bool
+}
+
+// testifyExpectations returns the expected calls registered with testify (in the order they were registered)
+//
+// The expected calls are collected by testify's own AssertExpectations - so that they are read with testify's lock held
+// and are judged as testify judges them (see expectationsCollector)
+func (mm *MockMethods) testifyExpectations() []testifyExpectation {
+	ec := &expectationsCollector{mock: &mm.Mock}
+	mm.Mock.AssertExpectations(ec)
+	return ec.expectations
+}
+
+// expectationsCollector is the mock.TestingT given to testify's AssertExpectations (see testifyExpectations)
+//
+// testify logs the check of each expected call (in order) with its lock held - so the expected call is read as it is logged
+type expectationsCollector struct {
+	mock         *mock.Mock
+	expectations []testifyExpectation
+}
+
+func (ec *expectationsCollector) Logf(format string, args ...any) {
+	if i := len(ec.expectations); i < len(ec.mock.ExpectedCalls) {
+		call := ec.mock.ExpectedCalls[i]
+		ec.expectations = append(ec.expectations, testifyExpectation{
+			call:          call,
+			method:        call.Method,
+			arguments:     call.Arguments,
+			repeatability: call.Repeatability,
+			satisfied:     strings.HasPrefix(format, "PASS:"),
+		})
+	}
+}
+
+func (ec *expectationsCollector) Errorf(format string, args ...any) {}
+
+func (ec *expectationsCollector) FailNow() {}
+
+// assertExpectations asserts that all expectations were met - reporting the unmet expectations (and where they were registered)
+func (mm *MockMethods) assertExpectations(t mock.TestingT) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	if s, ok := t.(interface{ Skipped() bool }); ok && s.Skipped() {
+		return true
+	}
+	expectations := mm.Expectations()
+	unmet := make([]string, 0)
+	for _, e := range expectations {
+		if e.Satisfied {
+			t.Logf("PASS:\t%s", callString(e.Method, e.Arguments))
+		} else {
+			t.Logf("FAIL:\t%s", e.String())
+			unmet = append(unmet, e.String())
+		}
+	}
+	if len(unmet) > 0 {
+		t.Errorf("FAIL: %d out of %d expectation(s) were met.\n\tThe code you are testing needs to make %d more call(s).\n\tUnmet expectations:\n\t\t%s",
+			len(expectations)-len(unmet), len(expectations), len(unmet), strings.Join(unmet, "\n\t\t"))
+	}
+	return len(unmet) == 0
+}
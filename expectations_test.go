@@ -0,0 +1,161 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMockMethods_Expectations(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.SetNice(true)
+	m.OnMethod(m.Save, "a").Named("save a").Return(nil)
+	On1R1(m, m.Delete).With("b").Named("delete b").Return(nil)
+	m.On("Delete", "c").Return(nil)
+	m.OnMethod(m.Delete, "d").Maybe().Return(nil)
+	_ = m.Save("a", 1, nil)
+	_ = m.Delete("x") // nice mock recorded call - not listed
+
+	expectations := m.Expectations()
+	require.Equal(t, 4, len(expectations))
+	assert.Equal(t, "Save", expectations[0].Method)
+	assert.Equal(t, mock.Arguments{"a", mock.Anything, mock.Anything}, expectations[0].Arguments)
+	assert.Equal(t, "save a", expectations[0].Name)
+	assert.Contains(t, expectations[0].Source, "expectations_test.go:13")
+	assert.Equal(t, 1, expectations[0].Calls)
+	assert.True(t, expectations[0].Satisfied)
+	assert.Equal(t, "delete b", expectations[1].Name)
+	assert.Contains(t, expectations[1].Source, "expectations_test.go:14")
+	assert.False(t, expectations[1].Satisfied)
+	assert.Equal(t, "", expectations[2].Name)
+	assert.Contains(t, expectations[2].Source, "expectations_test.go:15")
+	assert.False(t, expectations[2].Satisfied)
+	assert.True(t, expectations[3].Optional)
+	assert.True(t, expectations[3].Satisfied)
+
+	assert.Contains(t, expectations[1].String(), `"delete b" Delete("b") registered at: `)
+}
+
+func TestMockMethods_Expectations_OnAllMethods(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnAllMethods(false)
+	expectations := m.Expectations()
+	require.Equal(t, 2, len(expectations))
+	assert.Contains(t, expectations[0].Source, "expectations_test.go:42")
+	assert.Contains(t, expectations[1].Source, "expectations_test.go:42")
+}
+
+func TestMockMethods_AssertExpectations_ReportsSource(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	for _, key := range []string{"a", "b", "c"} {
+		m.OnMethod(m.Delete, key).Named("delete " + key).Once().Return(nil)
+	}
+	_ = m.Delete("a")
+	_ = m.Delete("c")
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertExpectations(ft))
+	require.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "FAIL: 2 out of 3 expectation(s) were met.")
+	assert.Contains(t, ft.errors[0], "Unmet expectations:\n\t\t\"delete b\" Delete(\"b\") registered at: ")
+	assert.Contains(t, ft.errors[0], "expectations_test.go:52")
+}
+
+func TestMockMethods_UnmatchedCallReport_Named(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[diagnosticMock, diagnosticIntf](ft)
+	m.OnMethod(m.Delete, "a").Named("delete a").Return(nil)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = m.Delete("b")
+	})
+	assert.Contains(t, ft.errors[0], "\n\t[1] \"delete a\" Delete(\"a\") registered at: ")
+}
+
+func TestMockMethods_Expectations_Concurrent(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete).Times(20).Return(nil)
+	done := make(chan struct{})
+	for i := 0; i < 20; i++ {
+		go func() {
+			_ = m.Delete("a")
+			done <- struct{}{}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		_ = m.Expectations()
+		<-done
+	}
+	expectations := m.Expectations()
+	require.Equal(t, 1, len(expectations))
+	assert.Equal(t, 20, expectations[0].Calls)
+	assert.Equal(t, 20, expectations[0].Times)
+	assert.True(t, expectations[0].Satisfied)
+}
+
+func TestMockMethods_AssertExpectations_RegisteredWithTestify(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.Mock.On("Delete", "a").Return(nil).On("Delete", "b").Return(nil)
+	_ = m.Delete("a")
+
+	expectations := m.Expectations()
+	require.Equal(t, 2, len(expectations))
+	assert.Equal(t, 1, expectations[0].Calls)
+	assert.True(t, expectations[0].Satisfied)
+	assert.False(t, expectations[1].Satisfied)
+	assert.Equal(t, `Delete("b")`, expectations[1].String())
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertExpectations(ft))
+	require.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "FAIL: 1 out of 2 expectation(s) were met.")
+	assert.Contains(t, ft.errors[0], "Unmet expectations:\n\t\tDelete(\"b\")")
+}
+
+func TestMockMethods_AssertExpectations_TimesOnTestifyCall(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.On("Delete", "a").Return(nil).Times(3)
+	m.On("Delete", "b").Return(nil).Maybe()
+	_ = m.Delete("a")
+
+	expectations := m.Expectations()
+	require.Equal(t, 2, len(expectations))
+	assert.Equal(t, 1, expectations[0].Calls)
+	assert.Equal(t, 3, expectations[0].Times)
+	assert.False(t, expectations[0].Satisfied)
+	assert.True(t, expectations[1].Optional)
+	assert.True(t, expectations[1].Satisfied)
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertExpectations(ft))
+	require.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "FAIL: 1 out of 2 expectation(s) were met.")
+
+	_ = m.Delete("a")
+	_ = m.Delete("a")
+	assert.True(t, m.AssertExpectations(t))
+}
+
+func TestMockMethods_AssertExpectations_Unset(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod(m.Delete, "a").Return(nil).Unset()
+	m.OnMethod(m.Delete, "b").Return(nil)
+	_ = m.Delete("b")
+
+	expectations := m.Expectations()
+	require.Equal(t, 1, len(expectations))
+	assert.Equal(t, mock.Arguments{"b"}, expectations[0].Arguments)
+	assert.True(t, m.AssertExpectations(t))
+}
+
+func TestMockMethods_Unset_InOrder(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.InOrder(func() {
+		m.OnMethod(m.Delete, "a").Return(nil).Unset()
+		m.OnMethod(m.Delete, "b").Return(nil)
+	})
+	assert.NotPanics(t, func() {
+		_ = m.Delete("b")
+	})
+	m.AssertExpectations(t)
+}
@@ -0,0 +1,249 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// FaultPolicy injects an error into the results of calls on a mock (see MockMethods.InjectFaults and Call.InjectFaults)
+//
+// The error replaces the error result of the mocked method (the call is still recorded) - and the policy counts the calls it
+// sees, so a policy shared by several mocks (or expectations) counts the calls on all of them
+type FaultPolicy struct {
+	name    string
+	err     error
+	seed    int64
+	fails   func(call int) bool
+	invalid string // why the policy args are invalid (reported when the policy is attached - see InjectFaults)
+	lock    sync.Mutex
+	calls   int
+}
+
+// FailEvery is a fault policy that fails every nth call (i.e. calls n, 2n, 3n etc.)
+//
+// n must be greater than 0 (InjectFaults fails otherwise)
+func FailEvery(n int, err error) *FaultPolicy {
+	p := &FaultPolicy{
+		name: fmt.Sprintf("FailEvery(%d)", n),
+		err:  err,
+		fails: func(call int) bool {
+			return n > 0 && call%n == 0
+		},
+	}
+	if n <= 0 {
+		p.invalid = fmt.Sprintf("FailEvery n must be greater than 0 but got %d", n)
+	}
+	return p
+}
+
+// FailAfter is a fault policy that fails every call after the first n calls
+//
+// n must not be negative (InjectFaults fails otherwise)
+func FailAfter(n int, err error) *FaultPolicy {
+	p := &FaultPolicy{
+		name: fmt.Sprintf("FailAfter(%d)", n),
+		err:  err,
+		fails: func(call int) bool {
+			return call > n
+		},
+	}
+	if n < 0 {
+		p.invalid = fmt.Sprintf("FailAfter n must not be negative but got %d", n)
+	}
+	return p
+}
+
+// FailRate is a fault policy that fails calls randomly at the specified rate (e.g. 0.1 fails approximately 1 in 10 calls)
+//
+// The calls that fail are determined by the seed - so that a run can be replayed (if the seed is 0, a seed is generated).
+// The seed is logged if the test fails (see MockMethods.AssertExpectations and MockMethods.Test) and can be obtained using FaultPolicy.Seed
+//
+// The rate must be between 0 and 1 (InjectFaults fails otherwise)
+func FailRate(rate float64, err error, seed int64) *FaultPolicy {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	p := &FaultPolicy{
+		name: fmt.Sprintf("FailRate(%v, seed: %d)", rate, seed),
+		err:  err,
+		seed: seed,
+		fails: func(call int) bool {
+			return rnd.Float64() < rate
+		},
+	}
+	if !(rate >= 0 && rate <= 1) {
+		p.invalid = fmt.Sprintf("FailRate rate must be between 0 and 1 but got %v", rate)
+	}
+	return p
+}
+
+// Seed returns the seed of a FailRate fault policy (or 0 for other policies)
+func (p *FaultPolicy) Seed() int64 {
+	return p.seed
+}
+
+// String returns a description of the fault policy (e.g. "FailRate(0.1, seed: 42)")
+func (p *FaultPolicy) String() string {
+	return p.name
+}
+
+// validate returns why the fault policy args are invalid (or an empty string if they are valid)
+func (p *FaultPolicy) validate() string {
+	if p == nil {
+		return "fault policy must not be nil"
+	} else if p.invalid != "" {
+		return p.invalid
+	} else if p.err == nil {
+		return p.name + " error must not be nil"
+	}
+	return ""
+}
+
+// next counts a call - returning whether the call fails
+func (p *FaultPolicy) next() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.calls++
+	return p.fails(p.calls)
+}
+
+// InjectFaults attaches fault policies to the mock - which inject errors into the results of calls of any method that has an error result
+//
+// Example:
+//
+//	myMock.InjectFaults(mmock.FailRate(0.1, errors.New("fail"), 42))
+func (mm *MockMethods) InjectFaults(policies ...*FaultPolicy) {
+	if mm.mockOf == nil {
+		mm.fail("mmock: InjectFaults cannot determine the error results of methods (use NewMock to create the mock)")
+		return
+	}
+	for _, p := range policies {
+		if invalid := p.validate(); invalid != "" {
+			mm.fail("mmock: InjectFaults %s", invalid)
+			return
+		}
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.faults = append(mm.faults, policies...)
+	mm.addFaultPolicies(policies)
+}
+
+// InjectFaults attaches fault policies to the expected call - which inject errors into the results of calls matched to it
+//
+// Example:
+//
+//	myMock.OnMethod(myMock.Fetch).Return(data, nil).InjectFaults(mmock.FailEvery(3, errTimeout))
+func (c *Call) InjectFaults(policies ...*FaultPolicy) *Call {
+	if c.method == nil {
+		c.mm.fail("mmock: %s() InjectFaults cannot determine the error result (mock type not known)", c.Method)
+		return c
+	} else if errorResultIndex(c.method) == -1 {
+		c.mm.fail("mmock: %s() InjectFaults method has no error result", c.Method)
+		return c
+	}
+	for _, p := range policies {
+		if invalid := p.validate(); invalid != "" {
+			c.mm.fail("mmock: %s() InjectFaults %s", c.Method, invalid)
+			return c
+		}
+	}
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.faults = append(c.faults, policies...)
+	c.mm.addFaultPolicies(policies)
+	return c
+}
+
+// addFaultPolicies adds to the fault policies reported on failure (see reportFaults) - must be called with the lock held
+func (mm *MockMethods) addFaultPolicies(policies []*FaultPolicy) {
+	for _, p := range policies {
+		found := false
+		for _, ep := range mm.faultPolicies {
+			found = found || ep == p
+		}
+		if !found {
+			mm.faultPolicies = append(mm.faultPolicies, p)
+		}
+	}
+}
+
+// applyFaults injects an error into the results of a call - if any of the fault policies of the matched expected call (or of the mock) fail
+// the call, or the call has an error to be returned (e.g. the context error of a blocked call - see Call.BlockUntilCancelled)
+func (mm *MockMethods) applyFaults(methodName string, inv *invocation, result mock.Arguments) mock.Arguments {
+	var method reflect.Type
+	mm.lock.Lock()
+	policies := append([]*FaultPolicy{}, mm.faults...)
+	if inv.call != nil {
+		policies = append(append([]*FaultPolicy{}, inv.call.faults...), policies...)
+		method = inv.call.method
+	}
+	mm.lock.Unlock()
+	if len(policies) == 0 {
+		return result
+	} else if mm.mockOf != nil {
+		if m, ok := reflect.TypeOf(mm.mockOf).MethodByName(methodName); ok {
+			method = methodFuncType(m)
+		}
+	}
+	errIndex := -1
+	if method != nil {
+		errIndex = errorResultIndex(method)
+	}
+	if errIndex == -1 {
+		return result
+	}
+	var err error
+	for _, p := range policies {
+		if p.next() && err == nil {
+			err = p.err
+		}
+	}
+	if err == nil {
+		return result
+	}
+	faulted := make(mock.Arguments, method.NumOut())
+	copy(faulted, result)
+	faulted[errIndex] = err
+	return faulted
+}
+
+// reportFaults logs the fault policies (e.g. the seed of FailRate) and chaos if the test has failed - so that the run can be replayed
+//
+// It is called by AssertExpectations and when the bound test finishes (see Test) - but only logs once
+func (mm *MockMethods) reportFaults(t mock.TestingT) {
+	f, ok := t.(interface{ Failed() bool })
+	if !ok || !f.Failed() {
+		return
+	}
+	mm.lock.Lock()
+	policies := append([]*FaultPolicy{}, mm.faultPolicies...)
+	chaos := mm.chaos
+	reported := mm.faultsReported
+	mm.faultsReported = reported || len(policies) > 0 || chaos != nil
+	mm.lock.Unlock()
+	if reported {
+		return
+	}
+	for _, p := range policies {
+		t.Logf("mmock: faults were injected by %s", p.String())
+	}
+	if chaos != nil {
+		t.Logf("mmock: chaos was injected by %s", chaos.report())
+	}
+}
+
+// errorResultIndex returns the index of the last error result of a method (or -1 if the method has no error result)
+func errorResultIndex(method reflect.Type) int {
+	for i := method.NumOut() - 1; i >= 0; i-- {
+		if method.Out(i) == errorType {
+			return i
+		}
+	}
+	return -1
+}
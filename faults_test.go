@@ -0,0 +1,172 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var errFault = errors.New("fault")
+
+func TestFailEvery(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{SomeValue: "a"}, nil).InjectFaults(FailEvery(3, errFault))
+
+	for i := 1; i <= 6; i++ {
+		r, err := m.DoSomething("a", i)
+		if i%3 == 0 {
+			assert.Equal(t, errFault, err)
+		} else {
+			assert.NoError(t, err)
+		}
+		// other results are not replaced...
+		assert.Equal(t, "a", r.SomeValue)
+	}
+	calls := m.CallsOf(m.DoSomething)
+	require.Equal(t, 6, len(calls))
+	assert.Equal(t, errFault, calls[2].Returns[1])
+	assert.Nil(t, calls[1].Returns[1])
+	m.AssertNumberOfMethodCalls(t, m.DoSomething, 6)
+}
+
+func TestFailAfter(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil).InjectFaults(FailAfter(2, errFault))
+
+	for i := 1; i <= 4; i++ {
+		_, err := m.DoSomething("a", i)
+		if i > 2 {
+			assert.Equal(t, errFault, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestFailRate(t *testing.T) {
+	failures := func(seed int64) []bool {
+		m := NewMockOf[mockedMy, my]()
+		m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil)
+		m.InjectFaults(FailRate(0.5, errFault, seed))
+		result := make([]bool, 20)
+		for i := range result {
+			_, err := m.DoSomething("a", i)
+			result[i] = err != nil
+		}
+		return result
+	}
+	first := failures(42)
+	assert.Equal(t, first, failures(42))
+	count := 0
+	for _, f := range first {
+		if f {
+			count++
+		}
+	}
+	assert.True(t, count > 0 && count < 20)
+
+	p := FailRate(0.1, errFault, 0)
+	assert.NotEqual(t, int64(0), p.Seed())
+	assert.Contains(t, p.String(), "FailRate(0.1, seed: ")
+	assert.Equal(t, int64(0), FailEvery(1, errFault).Seed())
+}
+
+func TestMockMethods_InjectFaults_SpyAndNice(t *testing.T) {
+	underlying := &underlyingFull{calls: map[string]int{}}
+	spy := NewSpyMockOf[mockedMy, my](underlying)
+	spy.InjectFaults(FailEvery(1, errFault))
+	_, err := spy.DoSomethingElse("a", 1)
+	assert.Equal(t, errFault, err)
+	assert.Equal(t, 1, underlying.calls["DoSomethingElse"])
+	spy.AssertMethodCalled(t, spy.DoSomethingElse, "a", 1)
+
+	m := NewMockOf[mockedMy, my]()
+	m.SetNice(true)
+	m.InjectFaults(FailEvery(2, errFault))
+	_, err = m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	_, err = m.DoSomething("a", 2)
+	assert.Equal(t, errFault, err)
+}
+
+func TestInjectFaults_Errors(t *testing.T) {
+	m := &mockedMy{}
+	assert.PanicsWithValue(t, "mmock: InjectFaults cannot determine the error results of methods (use NewMock to create the mock)", func() {
+		m.InjectFaults(FailEvery(1, errFault))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() InjectFaults cannot determine the error result (mock type not known)", func() {
+		m.OnMethod("DoSomething").InjectFaults(FailEvery(1, errFault))
+	})
+	cm := NewMock[captorMock]()
+	assert.PanicsWithValue(t, "mmock: Print() InjectFaults method has no error result", func() {
+		cm.OnMethod(cm.Print).InjectFaults(FailEvery(1, errFault))
+	})
+}
+
+func TestInjectFaults_InvalidPolicies(t *testing.T) {
+	m := NewMock[mockedMy]()
+	assert.PanicsWithValue(t, "mmock: InjectFaults FailEvery n must be greater than 0 but got 0", func() {
+		m.InjectFaults(FailEvery(0, errFault))
+	})
+	assert.PanicsWithValue(t, "mmock: InjectFaults FailAfter n must not be negative but got -1", func() {
+		m.InjectFaults(FailAfter(-1, errFault))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() InjectFaults FailRate rate must be between 0 and 1 but got 1.5", func() {
+		m.OnMethod(m.DoSomething).InjectFaults(FailRate(1.5, errFault, 42))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() InjectFaults FailRate rate must be between 0 and 1 but got -0.1", func() {
+		m.OnMethod(m.DoSomething).InjectFaults(FailRate(-0.1, errFault, 42))
+	})
+	assert.PanicsWithValue(t, "mmock: InjectFaults FailEvery(2) error must not be nil", func() {
+		m.InjectFaults(FailEvery(2, nil))
+	})
+	assert.PanicsWithValue(t, "mmock: InjectFaults fault policy must not be nil", func() {
+		m.InjectFaults(nil)
+	})
+}
+
+func TestInjectFaults_ReportedOnFailure(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil).InjectFaults(FailRate(0.5, errFault, 42))
+	m.OnMethod(m.DoSomethingElse).Return(SomeStruct{}, nil)
+	_, _ = m.DoSomething("a", 1)
+	ft.cleanup()
+	assert.True(t, ft.failed)
+	assert.Contains(t, ft.logs, "mmock: faults were injected by FailRate(0.5, seed: 42)")
+
+	ft = &fakeTB{TB: t}
+	m = NewMockT[mockedMy, my](ft)
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil).InjectFaults(FailRate(0.5, errFault, 42))
+	_, _ = m.DoSomething("a", 1)
+	ft.cleanup()
+	assert.False(t, ft.failed)
+	assert.NotContains(t, ft.logs, "mmock: faults were injected by FailRate(0.5, seed: 42)")
+}
+
+func TestInjectFaults_ReportedOnFailure_WithoutAssertExpectations(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMock[mockedMy]()
+	m.Test(ft)
+	m.InjectFaults(FailRate(0.5, errFault, 42))
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{}, nil)
+	_, _ = m.DoSomething("a", 1)
+	ft.Errorf("some failure")
+	ft.cleanup()
+	assert.Equal(t, []string{"mmock: faults were injected by FailRate(0.5, seed: 42)"}, ft.logs)
+
+	ft = &fakeTB{TB: t}
+	m = NewMockT[mockedMy, my](ft)
+	m.InjectFaults(FailRate(0.5, errFault, 42))
+	m.OnMethod(m.DoSomethingElse).Return(SomeStruct{}, nil)
+	ft.cleanup()
+	assert.True(t, ft.failed)
+	count := 0
+	for _, l := range ft.logs {
+		if l == "mmock: faults were injected by FailRate(0.5, seed: 42)" {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+}
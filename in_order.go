@@ -0,0 +1,143 @@
+package mmock
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InOrder sets up expected calls that must be called in order
+//
+// Any expected calls set up (using OnMethod or the typed OnNRM functions) within the func must be called in the order
+// they were set up - a call that is out of order fails at the time it is called, e.g.
+//
+//	myMock.InOrder(func() {
+//	  myMock.OnMethod(myMock.Begin)
+//	  myMock.OnMethod(myMock.Write).Times(2)
+//	  myMock.OnMethod(myMock.Commit)
+//	})
+//
+// An expected call may be called repeatedly (as allowed by Once, Times etc.) before the next expected call in the order - but
+// an expected call with Once, Times etc. must have been called that number of times before the next expected call.  Optional
+// expected calls (see Call.Maybe) may be skipped
+//
+// An out of order call is rejected before it is matched - so it is not counted against its expected call
+func (mm *MockMethods) InOrder(fn func()) {
+	mm.lock.Lock()
+	mm.ordering = &orderedGroup{}
+	mm.lock.Unlock()
+	defer func() {
+		mm.lock.Lock()
+		mm.ordering = nil
+		mm.lock.Unlock()
+	}()
+	fn()
+}
+
+// orderedGroup is a group of expected calls that must be called in order (see MockMethods.InOrder)
+type orderedGroup struct {
+	steps   []*orderedStep
+	current int
+}
+
+// add adds an expected call to the group (must be called with the mock locked)
+func (g *orderedGroup) add(c *Call) {
+	if g != nil {
+		c.order = g
+		g.steps = append(g.steps, &orderedStep{call: c})
+	}
+}
+
+// remove removes an expected call from the group (must be called with the mock locked)
+func (g *orderedGroup) remove(c *Call) {
+	if g == nil {
+		return
+	}
+	for i, step := range g.steps {
+		if step.call == c {
+			g.steps = append(g.steps[:i], g.steps[i+1:]...)
+			if g.current > i {
+				g.current--
+			}
+			return
+		}
+	}
+}
+
+type orderedStep struct {
+	call  *Call
+	calls int
+}
+
+// checkOrder checks that a call is not out of order (see InOrder) - returning a failure message if it is
+//
+// The check is made before testify matches the call (so that an out of order call is not counted against its expected call)
+func (mm *MockMethods) checkOrder(methodName string, arguments []any) string {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if c := mm.expectedCall(methodName, arguments); c != nil {
+		return c.order.called(c, arguments)
+	}
+	return ""
+}
+
+// expectedCall returns the expected call that a call will be matched to - the first expected call (in the order they were
+// set up) whose args match and whose expected calls have not already been made (must be called with the mock locked)
+func (mm *MockMethods) expectedCall(methodName string, arguments []any) *Call {
+	for _, c := range mm.expectations {
+		if c.Method == methodName && (c.times <= 0 || c.calls < c.times) {
+			if _, differences := c.Arguments.Diff(arguments); differences == 0 {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// called records the call of an expected call in the group - returning a failure message if the call is out of order
+//
+// (must be called with the mock locked)
+func (g *orderedGroup) called(c *Call, arguments []any) string {
+	if g == nil {
+		return ""
+	}
+	idx := -1
+	for i, step := range g.steps {
+		if step.call == c {
+			idx = i
+			break
+		}
+	}
+	if idx < g.current {
+		return g.failure(c, arguments, fmt.Sprintf("it was expected before %s", g.describe(g.steps[g.current].call)))
+	}
+	for i := g.current; i < idx; i++ {
+		step := g.steps[i]
+		if step.calls == 0 && step.call.optional {
+			continue
+		} else if step.call.times > 0 && step.calls < step.call.times {
+			return g.failure(c, arguments, fmt.Sprintf("expected next: %s (called %d of %d times)", g.describe(step.call), step.calls, step.call.times))
+		} else if step.calls == 0 {
+			return g.failure(c, arguments, "expected next: "+g.describe(step.call))
+		}
+	}
+	g.current = idx
+	g.steps[idx].calls++
+	return ""
+}
+
+func (g *orderedGroup) failure(c *Call, arguments []any, reason string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("mmock: out of order call: %s\n\tat: %s\n\t%s\n\texpected order:", callString(c.Method, arguments), callSite(), reason))
+	for i, step := range g.steps {
+		marker := " "
+		if i == g.current && step.calls > 0 {
+			marker = ">"
+		}
+		sb.WriteString(fmt.Sprintf("\n\t%s [%d] %s (called %d times)", marker, i+1, g.describe(step.call), step.calls))
+	}
+	return sb.String()
+}
+
+func (g *orderedGroup) describe(c *Call) string {
+	return c.expectation().String()
+}
@@ -0,0 +1,143 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMockMethods_InOrder(t *testing.T) {
+	m := NewMock[protocolMock]()
+	m.InOrder(func() {
+		m.OnMethod(m.Begin).Once()
+		m.OnMethod(m.Write).Times(2)
+		m.OnMethod(m.Commit).Once()
+	})
+	m.Begin()
+	m.Write("a")
+	m.Write("b")
+	m.Commit()
+	m.AssertExpectations(t)
+}
+
+func TestMockMethods_InOrder_FailsSkippedStep(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[protocolMock, protocol](ft)
+	m.InOrder(func() {
+		m.OnMethod(m.Begin).Once()
+		m.OnMethod(m.Write).Named("write")
+		m.OnMethod(m.Commit).Once()
+	})
+	m.Begin()
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		m.Commit()
+	})
+	assert.Contains(t, ft.errors[0], "mmock: out of order call: Commit()\n\tat: ")
+	assert.Contains(t, ft.errors[0], "in_order_test.go:32\n\texpected next: \"write\" Write(mock.Anything) registered at: ")
+	assert.Contains(t, ft.errors[0], "\n\texpected order:\n\t> [1] Begin() registered at: ")
+	assert.Contains(t, ft.errors[0], "(called 1 times)\n\t  [2] \"write\" Write(mock.Anything)")
+}
+
+func TestMockMethods_InOrder_FailsGoingBack(t *testing.T) {
+	m := NewMock[protocolMock]()
+	m.InOrder(func() {
+		m.OnMethod(m.Begin)
+		m.OnMethod(m.Write)
+	})
+	m.Begin()
+	m.Write("a")
+	assert.Panics(t, func() {
+		m.Begin()
+	})
+	ft := &fakeTB{TB: t}
+	m.Test(ft)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		m.Begin()
+	})
+	assert.Contains(t, ft.errors[0], "mmock: out of order call: Begin()\n\tat: ")
+	assert.Contains(t, ft.errors[0], "\n\tit was expected before Write(mock.Anything) registered at: ")
+}
+
+func TestMockMethods_InOrder_FailsTimesNotReached(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[protocolMock, protocol](ft)
+	m.InOrder(func() {
+		m.OnMethod(m.Write).Times(2)
+		m.OnMethod(m.Commit).Once()
+	})
+	m.Write("a")
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		m.Commit()
+	})
+	assert.Contains(t, ft.errors[0], "\n\texpected next: Write(mock.Anything) registered at: ")
+	assert.Contains(t, ft.errors[0], " (called 1 of 2 times)\n\texpected order:")
+}
+
+func TestMockMethods_InOrder_RejectedCallNotCounted(t *testing.T) {
+	m := NewMock[protocolMock]()
+	m.InOrder(func() {
+		m.OnMethod(m.Begin).Once()
+		m.OnMethod(m.Write, "a").Once()
+	})
+	r := func() (r any) {
+		defer func() {
+			r = recover()
+		}()
+		m.Write("a")
+		return
+	}()
+	assert.Contains(t, r, "mmock: out of order call: Write(\"a\")\n\tat: ")
+	assert.Equal(t, 0, len(m.CallsOf(m.Write)))
+	assert.Equal(t, 0, len(m.Calls))
+	m.Begin()
+	m.Write("a")
+	m.AssertExpectations(t)
+}
+
+func TestMockMethods_InOrder_OptionalSteps(t *testing.T) {
+	m := NewMock[protocolMock]()
+	m.InOrder(func() {
+		m.OnMethod(m.Begin)
+		m.OnMethod(m.Write).Maybe()
+		On0R0(m, m.Commit)
+	})
+	m.Begin()
+	m.Commit()
+	m.AssertExpectations(t)
+}
+
+func TestMockMethods_InOrder_UnorderedExpectations(t *testing.T) {
+	m := NewMock[protocolMock]()
+	m.OnMethod(m.Write)
+	m.InOrder(func() {
+		m.OnMethod(m.Begin)
+		m.OnMethod(m.Commit)
+	})
+	m.Write("a")
+	m.Begin()
+	m.Write("b")
+	m.Commit()
+	m.Write("c")
+	m.AssertExpectations(t)
+}
+
+type protocol interface {
+	Begin()
+	Write(s string)
+	Commit()
+}
+
+type protocolMock struct {
+	MockMethods
+}
+
+func (m *protocolMock) Begin() {
+	m.Called()
+}
+
+func (m *protocolMock) Write(s string) {
+	m.Called(s)
+}
+
+func (m *protocolMock) Commit() {
+	m.Called()
+}
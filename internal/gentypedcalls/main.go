@@ -0,0 +1,203 @@
+// Command gentypedcalls generates the typed calls (OnNRM functions and CallNRM types) of mmock
+//
+// Usage (from the module root - see the go:generate directive in typed_calls.go):
+//
+//	go run ./internal/gentypedcalls -o typed_calls_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// maxArgs and maxResults are the maximum number of method args and results for which typed calls are generated
+const (
+	maxArgs    = 4
+	maxResults = 4
+)
+
+func main() {
+	out := flag.String("o", "typed_calls_gen.go", "output file")
+	flag.Parse()
+	src, err := generate()
+	if err == nil {
+		err = os.WriteFile(*out, src, 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gentypedcalls:", err)
+		os.Exit(1)
+	}
+}
+
+func generate() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for a := 0; a <= maxArgs; a++ {
+		for r := 0; r <= maxResults; r++ {
+			if err := callTemplate.Execute(&buf, newArity(a, r)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+const header = `// Code generated by gentypedcalls. DO NOT EDIT.
+
+package mmock
+
+import "github.com/stretchr/testify/mock"
+`
+
+// arity is the template data for the typed call of a method with a number of args and results
+type arity struct {
+	Name         string // e.g. "Call2R1"
+	On           string // e.g. "On2R1"
+	Description  string // e.g. "2 args and 1 result"
+	TypeParams   string // e.g. "[A1, A2, R1 any]"
+	TypeArgs     string // e.g. "[A1, A2, R1]"
+	Func         string // e.g. "func(A1, A2) R1"
+	Args         int
+	Results      int
+	WithParams   string // e.g. "a1, a2 any"
+	WithArgs     string // e.g. "a1, a2"
+	ReturnParams string // e.g. "r1 R1, r2 R2"
+	ReturnArgs   string // e.g. "r1, r2"
+	CallArgs     string // e.g. "As[A1](args, 0), As[A2](args, 1)"
+	Example      string
+}
+
+func newArity(a, r int) arity {
+	var typeNames, argNames, resultTypes, resultNames, returnParams, callArgs, anys []string
+	for i := 1; i <= a; i++ {
+		typeNames = append(typeNames, fmt.Sprintf("A%d", i))
+		argNames = append(argNames, fmt.Sprintf("a%d", i))
+		callArgs = append(callArgs, fmt.Sprintf("As[A%d](args, %d)", i, i-1))
+		anys = append(anys, "mock.Anything")
+	}
+	argTypes := strings.Join(typeNames, ", ")
+	for i := 1; i <= r; i++ {
+		typeNames = append(typeNames, fmt.Sprintf("R%d", i))
+		resultTypes = append(resultTypes, fmt.Sprintf("R%d", i))
+		resultNames = append(resultNames, fmt.Sprintf("r%d", i))
+		returnParams = append(returnParams, fmt.Sprintf("r%d R%d", i, i))
+	}
+	result := &arity{
+		Name:         fmt.Sprintf("Call%dR%d", a, r),
+		On:           fmt.Sprintf("On%dR%d", a, r),
+		Description:  fmt.Sprintf("%s and %s", plural(a, "arg"), plural(r, "result")),
+		Func:         "func(" + argTypes + ")",
+		Args:         a,
+		Results:      r,
+		WithParams:   strings.Join(argNames, ", ") + " any",
+		WithArgs:     strings.Join(argNames, ", "),
+		ReturnParams: strings.Join(returnParams, ", "),
+		ReturnArgs:   strings.Join(resultNames, ", "),
+		CallArgs:     strings.Join(callArgs, ", "),
+		Example:      fmt.Sprintf("mmock.On%dR%d(myMock, myMock.SomeMethod)", a, r),
+	}
+	if len(typeNames) > 0 {
+		result.TypeParams = "[" + strings.Join(typeNames, ", ") + " any]"
+		result.TypeArgs = "[" + strings.Join(typeNames, ", ") + "]"
+	}
+	switch r {
+	case 0:
+	case 1:
+		result.Func += " " + resultTypes[0]
+	default:
+		result.Func += " (" + strings.Join(resultTypes, ", ") + ")"
+	}
+	if a > 0 {
+		result.Example += ".With(" + strings.Join(anys, ", ") + ")"
+	}
+	if r > 0 {
+		result.Example += ".Return(" + result.ReturnArgs + ")"
+	}
+	return *result
+}
+
+func plural(n int, what string) string {
+	switch n {
+	case 0:
+		return "no " + what + "s"
+	case 1:
+		return "1 " + what
+	}
+	return fmt.Sprintf("%d %ss", n, what)
+}
+
+var callTemplate = template.Must(template.New("call").Parse(`
+// {{.On}} sets up an expected call on a method with {{.Description}} - returning a typed call
+//
+// Example:
+//
+//	{{.Example}}
+func {{.On}}{{.TypeParams}}(m mockMethodsProvider, method {{.Func}}) *{{.Name}}{{.TypeArgs}} {
+	return &{{.Name}}{{.TypeArgs}}{typedCall: newTypedCall(m, method)}
+}
+
+// {{.Name}} is a typed call for a method with {{.Description}}
+type {{.Name}}{{.TypeParams}} struct {
+	typedCall
+}
+{{if .Args}}
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *{{.Name}}{{.TypeArgs}}) With({{.WithParams}}) *{{.Name}}{{.TypeArgs}} {
+	c.with({{.WithArgs}})
+	return c
+}
+{{end}}{{if .Results}}
+// Return sets the values to be returned by the call
+func (c *{{.Name}}{{.TypeArgs}}) Return({{.ReturnParams}}) *{{.Name}}{{.TypeArgs}} {
+	c.Call.Return({{.ReturnArgs}})
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *{{.Name}}{{.TypeArgs}}) Do(fn {{.Func}}) *{{.Name}}{{.TypeArgs}} {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		{{.ReturnArgs}} := fn({{.CallArgs}})
+		return mock.Arguments{ {{- .ReturnArgs -}} }
+	})
+	return c
+}
+{{else}}
+// Do sets a func to be called with the actual args of the call
+func (c *{{.Name}}{{.TypeArgs}}) Do(fn {{.Func}}) *{{.Name}}{{.TypeArgs}} {
+	c.Call.Run(func(args mock.Arguments) {
+		fn({{.CallArgs}})
+	})
+	return c
+}
+{{end}}
+// Once indicates that the call should only be expected once
+func (c *{{.Name}}{{.TypeArgs}}) Once() *{{.Name}}{{.TypeArgs}} {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *{{.Name}}{{.TypeArgs}}) Times(i int) *{{.Name}}{{.TypeArgs}} {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *{{.Name}}{{.TypeArgs}}) Maybe() *{{.Name}}{{.TypeArgs}} {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *{{.Name}}{{.TypeArgs}}) Named(name string) *{{.Name}}{{.TypeArgs}} {
+	c.Call.Named(name)
+	return c
+}
+`))
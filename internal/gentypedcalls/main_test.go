@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	src, err := generate()
+	require.NoError(t, err)
+	existing, err := os.ReadFile("../../typed_calls_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(existing), string(src), "typed_calls_gen.go is out of date - run go generate")
+}
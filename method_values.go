@@ -0,0 +1,73 @@
+package mmock
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// methodValueReceiver returns the receiver bound to a method value (e.g. myMock.SomeMethod) - where the method is declared
+// with a pointer receiver of the specified type
+//
+// returns false if the func is not such a method value (e.g. it's a method expression, plain func, value receiver method or
+// a method promoted from an embedded struct) - in which case the method can only be resolved by name
+func methodValueReceiver(method any, receiverType reflect.Type) (unsafe.Pointer, bool) {
+	fv := reflect.ValueOf(method)
+	if fv.Kind() != reflect.Func || fv.IsNil() {
+		return nil, false
+	}
+	fn := runtime.FuncForPC(fv.Pointer())
+	if fn == nil {
+		return nil, false
+	}
+	name := fn.Name()
+	if !strings.HasSuffix(name, "-fm") || !strings.HasPrefix(name, receiverTypeName(receiverType)+".") {
+		return nil, false
+	}
+	// a method value is a closure - whose func value points to the code pointer followed by the bound receiver...
+	type eface struct {
+		typ  unsafe.Pointer
+		data unsafe.Pointer
+	}
+	closure := (*eface)(unsafe.Pointer(&method)).data
+	return *(*unsafe.Pointer)(unsafe.Add(closure, unsafe.Sizeof(uintptr(0)))), true
+}
+
+// receiverTypeName returns the name of a pointer receiver type as it appears in func names (e.g. "example.com/stuff.(*MyMock)")
+//
+// returns an empty string if the type is not a pointer to a named type
+func receiverTypeName(t reflect.Type) string {
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Name() == "" {
+		return ""
+	}
+	name := t.Elem().Name()
+	if i := strings.Index(name, "["); i != -1 {
+		// generic type - func names do not include the type args...
+		name = name[:i] + "[...]"
+	}
+	return t.Elem().PkgPath() + ".(*" + name + ")"
+}
+
+// isReceiverOf determines whether this mock is the receiver bound to a method value
+//
+// returns true if the receiver of the method value cannot be determined (e.g. the mock type is not known or the
+// method is promoted from an embedded struct)
+func (mm *MockMethods) isReceiverOf(method any) bool {
+	if mm.mockOf == nil {
+		return true
+	}
+	receiver, ok := methodValueReceiver(method, reflect.TypeOf(mm.mockOf))
+	return !ok || receiver == unsafe.Pointer(mm) || receiver == reflect.ValueOf(mm.mockOf).UnsafePointer()
+}
+
+// isMethodExpression determines whether a func is a method expression (e.g. (*MyMock).SomeMethod or MyInterface.SomeMethod)
+//
+// i.e. it is not a method value (which is a closure named with suffix "-fm") and its first arg is a type that has the method
+func isMethodExpression(fullName string, methodName string, ft reflect.Type) bool {
+	if strings.HasSuffix(fullName, "-fm") || ft.NumIn() == 0 {
+		return false
+	}
+	_, ok := ft.In(0).MethodByName(methodName)
+	return ok
+}
@@ -0,0 +1,125 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func TestMockMethods_OnMethod_FailsWithOtherMockInstance(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	other := NewMock[diagnosticMock]()
+	assert.PanicsWithValue(t, "mmock: method value Delete() belongs to a different mock instance - not this mock (*mmock.diagnosticMock)", func() {
+		m.OnMethod(other.Delete)
+	})
+	assert.PanicsWithValue(t, "mmock: method value Delete() belongs to a different mock instance - not this mock (*mmock.diagnosticMock)", func() {
+		m.AssertMethodCalled(t, other.Delete)
+	})
+	assert.Panics(t, func() {
+		m.AssertMethodNotCalled(t, other.Delete)
+	})
+	assert.Panics(t, func() {
+		m.AssertNumberOfMethodCalls(t, other.Delete, 0)
+	})
+	assert.Panics(t, func() {
+		m.CallsOf(other.Delete)
+	})
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.Delete).Return(nil)
+		_ = m.Delete("a")
+		m.AssertMethodCalled(t, m.Delete)
+	})
+}
+
+func TestMockMethods_OnMethod_OtherMockInstance_ReportedToTest(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[diagnosticMock, diagnosticIntf](ft)
+	other := NewMock[diagnosticMock]()
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		m.OnMethod(other.Save)
+	})
+	assert.Equal(t, []string{"mmock: method value Save() belongs to a different mock instance - not this mock (*mmock.diagnosticMock)"}, ft.errors)
+}
+
+func TestMockMethods_OnMethod_WithoutMockType(t *testing.T) {
+	m := new(diagnosticMock)
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.Delete).Return(nil)
+	})
+	_ = m.Delete("a")
+	m.AssertMethodCalled(t, m.Delete, "a")
+}
+
+func TestMockMethods_OnMethod_MethodExpressions(t *testing.T) {
+	m := NewMock[diagnosticMock]()
+	m.OnMethod((*diagnosticMock).Delete, "a").Return(nil)
+	m.OnMethod(diagnosticIntf.Save, "b").Return(nil)
+	_ = m.Delete("a")
+	_ = m.Save("b", 1, nil)
+	m.AssertMethodCalled(t, (*diagnosticMock).Delete, "a")
+	m.AssertMethodCalled(t, diagnosticIntf.Save, "b")
+	m.AssertNumberOfMethodCalls(t, diagnosticIntf.Save, 1)
+	assert.Equal(t, 1, len(m.CallsOf((*diagnosticMock).Save)))
+	m.AssertExpectations(t)
+
+	// arg count is without the receiver...
+	assert.PanicsWithValue(t, "mmock: Delete() expected at most 1 args but got 2", func() {
+		m.OnMethod((*diagnosticMock).Delete, "a", "b")
+	})
+	assert.PanicsWithValue(t, "mmock: Save() arg [0]: expected type string but got int", func() {
+		m.OnMethod(diagnosticIntf.Save, 1)
+	})
+}
+
+func TestMockMethods_OnMethod_FailsWithOtherTypeMethodExpression(t *testing.T) {
+	m := NewMock[protocolMock]()
+	assert.PanicsWithValue(t, "mmock: method expression Write() is for type mmock.byteWriter - not this mock (*mmock.protocolMock)", func() {
+		m.OnMethod(byteWriter.Write)
+	})
+}
+
+func TestIsMethodExpression(t *testing.T) {
+	m := &diagnosticMock{}
+	assert.False(t, isMethodExpression("github.com/go-andiamo/mmock.(*diagnosticMock).Delete-fm", "Delete", reflect.TypeOf(m.Delete)))
+	assert.True(t, isMethodExpression("github.com/go-andiamo/mmock.(*diagnosticMock).Delete", "Delete", reflect.TypeOf((*diagnosticMock).Delete)))
+	assert.True(t, isMethodExpression("github.com/go-andiamo/mmock.diagnosticIntf.Delete", "Delete", reflect.TypeOf(diagnosticIntf.Delete)))
+	assert.False(t, isMethodExpression("github.com/go-andiamo/mmock.someFunc", "someFunc", reflect.TypeOf(func(s string) {})))
+}
+
+type byteWriter interface {
+	Write(b []byte) (int, error)
+}
+
+func TestMockMethods_OnMethod_PromotedFromEmbeddedMock(t *testing.T) {
+	m := &embeddingMock{embeddedBaseMock: &embeddedBaseMock{name: "base"}}
+	assert.True(t, setMockOf(m))
+	assert.NotPanics(t, func() {
+		m.OnMethod(m.Delete, "a").Return(nil)
+		m.OnMethod(m.Get, "a").Return("value")
+	})
+	assert.NoError(t, m.Delete("a"))
+	assert.Equal(t, "value", m.Get("a"))
+	assert.NotPanics(t, func() {
+		m.AssertMethodCalled(t, m.Delete, "a")
+		m.AssertNumberOfMethodCalls(t, m.Get, 1)
+		assert.Equal(t, 1, len(m.CallsOf(m.Delete)))
+	})
+	m.AssertExpectations(t)
+}
+
+type embeddedBaseMock struct {
+	name string
+	MockMethods
+}
+
+func (m *embeddedBaseMock) Delete(key string) error {
+	return As1[error](m.Called(key))
+}
+
+type embeddingMock struct {
+	*embeddedBaseMock
+}
+
+func (m *embeddingMock) Get(key string) string {
+	return As1[string](m.Called(key))
+}
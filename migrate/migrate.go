@@ -0,0 +1,251 @@
+// Package migrate - rewrites tests that use string named testify mock methods to use mmock method values
+//
+/*
+For example, where tests have...
+
+	m.On("DoSomething", mock.Anything).Return(nil)
+	m.AssertCalled(t, "DoSomething", "a")
+	m.AssertNumberOfCalls(t, "DoSomething", 1)
+
+these are rewritten as...
+
+	m.OnMethod(m.DoSomething, mock.Anything).Return(nil)
+	m.AssertMethodCalled(t, m.DoSomething, "a")
+	m.AssertNumberOfMethodCalls(t, m.DoSomething, 1)
+
+and mock structs that embed mock.Mock are changed to embed mmock.MockMethods
+*/
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+)
+
+const (
+	pkgTestifyMock = "github.com/stretchr/testify/mock"
+	pkgMmock       = "github.com/go-andiamo/mmock"
+)
+
+// Result is the result of Migrate
+type Result struct {
+	// Changes is the files that were changed by the migration
+	Changes []FileChange
+	// Warnings lists string named calls that could not be migrated (and why)
+	Warnings []string
+}
+
+// FileChange is a single file changed by the migration
+type FileChange struct {
+	// Filename is the full path of the changed file
+	Filename string
+	// Original is the original content of the file
+	Original []byte
+	// Migrated is the migrated content of the file
+	Migrated []byte
+	// Rewrites is the number of rewrites made to the file
+	Rewrites int
+}
+
+// Diff returns a unified diff of the original and migrated file content
+func (fc FileChange) Diff() string {
+	name := fc.Filename
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, name); err == nil {
+			name = rel
+		}
+	}
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fc.Original)),
+		B:        difflib.SplitLines(string(fc.Migrated)),
+		FromFile: "a/" + filepath.ToSlash(name),
+		ToFile:   "b/" + filepath.ToSlash(name),
+		Context:  3,
+	})
+	return diff
+}
+
+// Write writes the migrated content back to the file
+func (fc FileChange) Write() error {
+	fi, err := os.Stat(fc.Filename)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fc.Filename, fc.Migrated, fi.Mode().Perm())
+}
+
+// Migrate loads the packages specified by the patterns (as used by 'go list', e.g. "./...") and
+// determines the changes needed to migrate string named testify mock methods to mmock method values
+//
+// Migrate does not write any changes - use FileChange.Write to apply the changes (or FileChange.Diff for a dry-run)
+func Migrate(patterns ...string) (*Result, error) {
+	pkgs, err := listPackages(patterns)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+	checked := make([]*checkedPackage, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		cps, err := pkg.check(fset, imp)
+		if err != nil {
+			return nil, err
+		}
+		checked = append(checked, cps...)
+	}
+	// first pass - find all the mock types that will be migrated...
+	mocks := map[string]bool{}
+	for _, cp := range checked {
+		cp.collectMocks(mocks)
+	}
+	// second pass - rewrite...
+	result := &Result{
+		Changes:  []FileChange{},
+		Warnings: []string{},
+	}
+	for _, cp := range checked {
+		for _, f := range cp.files {
+			fr := newFileRewriter(fset, cp, f, mocks)
+			change, warnings, err := fr.rewrite()
+			if err != nil {
+				return nil, err
+			}
+			result.Warnings = append(result.Warnings, warnings...)
+			if change != nil {
+				result.Changes = append(result.Changes, *change)
+			}
+		}
+	}
+	sort.Slice(result.Changes, func(i, j int) bool {
+		return result.Changes[i].Filename < result.Changes[j].Filename
+	})
+	return result, nil
+}
+
+type listedPackage struct {
+	Dir          string
+	ImportPath   string
+	GoFiles      []string
+	CgoFiles     []string
+	TestGoFiles  []string
+	XTestGoFiles []string
+}
+
+func listPackages(patterns []string) ([]listedPackage, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", append([]string{"list", "-e", "-json=Dir,ImportPath,GoFiles,CgoFiles,TestGoFiles,XTestGoFiles"}, patterns...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, stderr.String())
+	}
+	result := make([]listedPackage, 0)
+	dec := json.NewDecoder(&stdout)
+	for {
+		var pkg listedPackage
+		if err := dec.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		result = append(result, pkg)
+	}
+	return result, nil
+}
+
+type checkedPackage struct {
+	pkg   *types.Package
+	info  *types.Info
+	files []*ast.File
+}
+
+func (lp listedPackage) check(fset *token.FileSet, imp types.Importer) ([]*checkedPackage, error) {
+	result := make([]*checkedPackage, 0, 2)
+	names := append(append(append([]string{}, lp.GoFiles...), lp.CgoFiles...), lp.TestGoFiles...)
+	if cp, err := checkFiles(fset, imp, lp.Dir, lp.ImportPath, names); err != nil {
+		return nil, err
+	} else if cp != nil {
+		result = append(result, cp)
+	}
+	if cp, err := checkFiles(fset, imp, lp.Dir, lp.ImportPath+"_test", lp.XTestGoFiles); err != nil {
+		return nil, err
+	} else if cp != nil {
+		result = append(result, cp)
+	}
+	return result, nil
+}
+
+func checkFiles(fset *token.FileSet, imp types.Importer, dir string, path string, names []string) (*checkedPackage, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	info := &types.Info{
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Defs:       map[*ast.Ident]types.Object{},
+		Uses:       map[*ast.Ident]types.Object{},
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+	}
+	conf := types.Config{
+		Importer: imp,
+		// type errors are ignored - anything that could not be resolved is simply not migrated
+		Error: func(err error) {},
+	}
+	pkg, _ := conf.Check(path, fset, files, info)
+	return &checkedPackage{
+		pkg:   pkg,
+		info:  info,
+		files: files,
+	}, nil
+}
+
+func (cp *checkedPackage) collectMocks(mocks map[string]bool) {
+	for _, obj := range cp.info.Defs {
+		if tn, ok := obj.(*types.TypeName); ok && tn.Parent() == tn.Pkg().Scope() {
+			if st, ok := tn.Type().Underlying().(*types.Struct); ok {
+				for i := 0; i < st.NumFields(); i++ {
+					if f := st.Field(i); f.Embedded() && isNamed(f.Type(), pkgTestifyMock, "Mock") {
+						mocks[typeKey(tn)] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func typeKey(tn *types.TypeName) string {
+	if tn.Pkg() == nil {
+		return tn.Name()
+	}
+	return tn.Pkg().Path() + "." + tn.Name()
+}
+
+func isNamed(t types.Type, pkgPath string, name string) bool {
+	if nt, ok := t.(*types.Named); ok {
+		obj := nt.Obj()
+		return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package migrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	result, err := Migrate("./testdata/things")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Changes))
+
+	assert.True(t, strings.HasSuffix(result.Changes[0].Filename, "things.go"))
+	assert.Equal(t, 1, result.Changes[0].Rewrites)
+	migrated := string(result.Changes[0].Migrated)
+	assert.Contains(t, migrated, "\tmmock.MockMethods\n")
+	assert.Contains(t, migrated, "\"github.com/go-andiamo/mmock\"")
+	assert.NotContains(t, migrated, "\"github.com/stretchr/testify/mock\"")
+
+	assert.True(t, strings.HasSuffix(result.Changes[1].Filename, "things_ext_test.go"))
+	assert.Equal(t, 1, result.Changes[1].Rewrites)
+	assert.Contains(t, string(result.Changes[1].Migrated), `m.OnMethod(m.DoSomething, "a").Return("a", nil)`)
+
+	assert.True(t, strings.HasSuffix(result.Changes[2].Filename, "things_test.go"))
+	assert.Equal(t, 5, result.Changes[2].Rewrites)
+	migrated = string(result.Changes[2].Migrated)
+	assert.Contains(t, migrated, `m.OnMethod(m.DoSomething, mock.Anything).Return("a", nil)`)
+	assert.Contains(t, migrated, `m.OnMethod(m.DoSomethingElse, 1).Return(nil)`)
+	assert.Contains(t, migrated, `m.AssertMethodCalled(t, m.DoSomething, "x")`)
+	assert.Contains(t, migrated, `m.AssertMethodNotCalled(t, m.DoSomethingElse)`)
+	assert.Contains(t, migrated, `m.AssertNumberOfMethodCalls(t, m.DoSomething, 1)`)
+	assert.Contains(t, migrated, "\"github.com/stretchr/testify/mock\"")
+	// these could not be migrated...
+	assert.Contains(t, migrated, `newMock().On("DoSomething")`)
+	assert.Contains(t, migrated, `m.On("Unknown")`)
+	assert.Contains(t, migrated, `m.Mock.On("DoSomething")`)
+
+	require.Equal(t, 4, len(result.Warnings))
+	assert.Contains(t, result.Warnings[0], "migrated, but now asserts")
+	assert.Contains(t, result.Warnings[1], "receiver expression is not a simple variable")
+	assert.Contains(t, result.Warnings[2], `method "Unknown" does not exist`)
+	assert.Contains(t, result.Warnings[3], "method is called directly on mock.Mock")
+}
+
+func TestFileChange_Diff(t *testing.T) {
+	fc := FileChange{
+		Filename: "foo.go",
+		Original: []byte("package foo\n\nvar a = 1\n"),
+		Migrated: []byte("package foo\n\nvar a = 2\n"),
+	}
+	diff := fc.Diff()
+	assert.Contains(t, diff, "--- a/foo.go\n+++ b/foo.go\n")
+	assert.Contains(t, diff, "-var a = 1\n+var a = 2\n")
+}
+
+func TestApplyEdits(t *testing.T) {
+	src := []byte("abcdef")
+	result := applyEdits(src, []edit{{start: 1, end: 2, text: "BB"}, {start: 4, end: 4, text: "X"}, {start: 5, end: 6}})
+	assert.Equal(t, "aBBcdXe", string(result))
+	assert.Equal(t, "abcdef", string(src))
+}
+
+func TestWholeLines(t *testing.T) {
+	src := []byte("line 1\n  line 2\nline 3")
+	e := wholeLines(src, 9, 11)
+	assert.Equal(t, 7, e.start)
+	assert.Equal(t, 16, e.end)
+	e = wholeLines(src, 18, 19)
+	assert.Equal(t, 16, e.start)
+	assert.Equal(t, 22, e.end)
+}
+
+func TestConvertMockery(t *testing.T) {
+	change, warnings, err := ConvertMockery("./testdata/mockery/mock_thing.go", MockeryOptions{Interface: "example.com/stuff.Thing"})
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, 1, change.Rewrites)
+	assert.Equal(t, 0, len(warnings))
+
+	converted := string(change.Migrated)
+	assert.NotContains(t, converted, "Code generated by mockery")
+	assert.Contains(t, converted, "import (\n\t\"context\"\n\t\"example.com/stuff\"\n\t\"github.com/go-andiamo/mmock\"\n\t\"github.com/stretchr/testify/mock\"\n)\n")
+	assert.Contains(t, converted, "type MockThing struct {\n\tmmock.MockMethods\n}\n")
+	assert.Contains(t, converted, "\tm := mmock.NewMockOf[MockThing, stuff.Thing]()\n\tm.Test(t)\n\tt.Cleanup(func() { m.AssertExpectations(t) })\n")
+	assert.Contains(t, converted, "var _ stuff.Thing = &MockThing{}\n")
+	assert.Contains(t, converted, "func NewMockThing(t interface {\n\tmock.TestingT\n\tCleanup(func())\n}) *MockThing {\n")
+	assert.Contains(t, converted, "func (m *MockThing) DoSomething(arg1 context.Context, arg2 string) (*stuff.SomeStruct, error) {\n\tretArgs := m.Called(arg1, arg2)\n"+
+		"\tif len(retArgs) > 0 {\n\t\tif rf, ok := retArgs[0].(func(context.Context, string) (*stuff.SomeStruct, error)); ok {\n\t\t\treturn rf(arg1, arg2)\n\t\t}\n\t}\n"+
+		"\tvar r0 *stuff.SomeStruct\n\tif rf, ok := retArgs.Get(0).(func(context.Context, string) *stuff.SomeStruct); ok {\n\t\tr0 = rf(arg1, arg2)\n\t} else {\n\t\tr0 = mmock.As[*stuff.SomeStruct](retArgs, 0)\n\t}\n"+
+		"\tvar r1 error\n\tif rf, ok := retArgs.Get(1).(func(context.Context, string) error); ok {\n\t\tr1 = rf(arg1, arg2)\n\t} else {\n\t\tr1 = mmock.As[error](retArgs, 1)\n\t}\n"+
+		"\treturn r0, r1\n}\n")
+	assert.Contains(t, converted, "func (m *MockThing) Log(arg1 string, arg2 ...interface{}) {\n\targs := make([]any, 0)\n\targs = append(args, arg1)\n\targs = append(args, arg2...)\n\tm.Called(args...)\n}\n")
+	assert.Contains(t, converted, "func (m *MockThing) Sum(arg1 ...int) int {\n\targs := make([]any, 0)\n\tfor _, v := range arg1 {\n\t\targs = append(args, v)\n\t}\n\tretArgs := m.Called(args...)\n"+
+		"\tvar r0 int\n\tif rf, ok := retArgs.Get(0).(func(...int) int); ok {\n\t\tr0 = rf(arg1...)\n\t} else {\n\t\tr0 = mmock.As[int](retArgs, 0)\n\t}\n\treturn r0\n}\n")
+	// expecter is retained...
+	assert.Contains(t, converted, "func (_m *MockThing) EXPECT() *MockThing_Expecter {")
+	assert.Contains(t, converted, "func (_c *MockThing_DoSomething_Call) Return(_a0 *stuff.SomeStruct, _a1 error) *MockThing_DoSomething_Call {")
+	// RunAndReturn is retained (the mocked method calls the func returned)...
+	assert.Contains(t, converted, "func (_c *MockThing_DoSomething_Call) RunAndReturn(run func(context.Context, string) (*stuff.SomeStruct, error)) *MockThing_DoSomething_Call {")
+	assert.NotContains(t, converted, "ret.Get(0)")
+	typeCheckConverted(t, change.Migrated)
+}
+
+func TestConvertMockery_WithoutInterface(t *testing.T) {
+	change, _, err := ConvertMockery("./testdata/mockery/mock_thing.go", MockeryOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	converted := string(change.Migrated)
+	assert.Contains(t, converted, "// MockThing is an mmock mock for the Thing type\n")
+	assert.Contains(t, converted, "\tm := mmock.NewMock[MockThing]()\n")
+	assert.NotContains(t, converted, "var _ ")
+	typeCheckConverted(t, change.Migrated)
+}
+
+func TestConvertMockery_AliasedImport(t *testing.T) {
+	change, warnings, err := ConvertMockery("./testdata/mockery/mock_aliased.go", MockeryOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, 0, len(warnings))
+	converted := string(change.Migrated)
+	assert.Contains(t, converted, "\ttestifymock \"github.com/stretchr/testify/mock\"\n")
+	assert.Contains(t, converted, "func NewMockNotifier(t interface {\n\ttestifymock.TestingT\n\tCleanup(func())\n}) *MockNotifier {\n")
+	assert.NotContains(t, converted, "\tmock.TestingT")
+	assert.Contains(t, converted, "func (m *MockNotifier) Notify(arg1 string) {\n\tretArgs := m.Called(arg1)\n"+
+		"\tif len(retArgs) > 0 {\n\t\tif rf, ok := retArgs[0].(func(string)); ok {\n\t\t\trf(arg1)\n\t\t\treturn\n\t\t}\n\t}\n}\n")
+	assert.Contains(t, converted, "func (_c *MockNotifier_Notify_Call) RunAndReturn(run func(string)) *MockNotifier_Notify_Call {")
+	typeCheckConverted(t, change.Migrated)
+}
+
+func TestConvertMockery_NotMockery(t *testing.T) {
+	change, _, err := ConvertMockery("./testdata/things/things_test.go", MockeryOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, change)
+}
+
+// typeCheckConverted type checks a converted mockery mock - resolving example.com/stuff to the testdata stub of the mocked package
+func typeCheckConverted(t *testing.T, code []byte) {
+	if convertedImporter == nil {
+		// the importer is shared (so that imported packages are only type checked once)...
+		fset := token.NewFileSet()
+		convertedImporter = &stuffImporter{fset: fset, imp: importer.ForCompiler(fset, "source", nil)}
+	}
+	fset := convertedImporter.fset
+	f, err := parser.ParseFile(fset, "converted.go", code, 0)
+	require.NoError(t, err)
+	_, err = (&types.Config{Importer: convertedImporter}).Check("mocks", fset, []*ast.File{f}, nil)
+	require.NoError(t, err, string(code))
+}
+
+var convertedImporter *stuffImporter
+
+type stuffImporter struct {
+	fset *token.FileSet
+	imp  types.Importer
+}
+
+func (si *stuffImporter) Import(path string) (*types.Package, error) {
+	if path != "example.com/stuff" {
+		return si.imp.Import(path)
+	}
+	f, err := parser.ParseFile(si.fset, "./testdata/mockery/stuff/stuff.go", nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return (&types.Config{Importer: si.imp}).Check(path, si.fset, []*ast.File{f}, nil)
+}
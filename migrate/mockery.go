@@ -0,0 +1,489 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MockeryOptions is the options for ConvertMockery
+type MockeryOptions struct {
+	// Interface is the interface type that is mocked - either as an import path qualified name
+	// (e.g. "example.com/stuff.Thing") or just the name when the interface is in the same package as the mock (e.g. "Thing")
+	//
+	// If this is empty, the converted mock constructors do not check that the mock implements the interface
+	Interface string
+}
+
+// ConvertMockery converts a mockery (https://github.com/vektra/mockery) generated mock file into an mmock mock
+//
+// The mocked methods are regenerated as mmock methods, and the generated NewMockXxx(t) constructor is retained (so
+// that existing tests continue to work) but creates the mock as an mmock.MockMethods mock.  Any typed EXPECT() helpers are
+// also retained - and where a func is returned (e.g. by RunAndReturn, or for a single result), the regenerated mocked method
+// calls the func (as mockery's mocked methods do)
+//
+// Returns nil (and no error) if the file does not contain any mockery mocks
+func ConvertMockery(filename string, opts MockeryOptions) (*FileChange, []string, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+	mc := &mockeryConverter{
+		fset:     fset,
+		file:     f,
+		src:      src,
+		opts:     opts,
+		mocks:    map[string]*mockeryMock{},
+		warnings: []string{},
+	}
+	code, err := mc.convert()
+	if err != nil || code == nil {
+		return nil, mc.warnings, err
+	}
+	return &FileChange{
+		Filename: filename,
+		Original: src,
+		Migrated: code,
+		Rewrites: len(mc.mocks),
+	}, mc.warnings, nil
+}
+
+type mockeryMock struct {
+	name    string
+	intf    string
+	methods []*ast.FuncDecl
+	// the names of methods whose EXPECT() helper has RunAndReturn (which sets a func as the return value)
+	returnFuncs map[string]bool
+}
+
+type mockeryConverter struct {
+	fset     *token.FileSet
+	file     *ast.File
+	src      []byte
+	opts     MockeryOptions
+	mocks    map[string]*mockeryMock
+	order    []string
+	warnings []string
+}
+
+// maxAsN is the maximum number of results for which an mmock.AsN func is available
+const maxAsN = 8
+
+var mockeryIntfRegex = regexp.MustCompile(`mock type for the (\w+) type`)
+
+func (mc *mockeryConverter) convert() ([]byte, error) {
+	mockName := "mock"
+	for _, spec := range mc.file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == pkgTestifyMock {
+			mockName = importName(spec, "mock")
+		}
+	}
+	// find the mock types...
+	for _, decl := range mc.file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok && embedsMock(st, mockName) {
+					mc.addMock(ts.Name.Name, gd.Doc.Text()+ts.Doc.Text())
+				}
+			}
+		}
+	}
+	if len(mc.mocks) == 0 {
+		return nil, nil
+	}
+	// collect the mocked methods and everything else that is retained...
+	retained := make([]ast.Decl, 0)
+	for _, decl := range mc.file.Decls {
+		if mc.isMockDecl(decl) {
+			continue
+		}
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv != nil {
+			if mock, ok := mc.mocks[receiverName(fd)]; ok {
+				if fd.Name.Name == "EXPECT" {
+					retained = append(retained, decl)
+				} else {
+					mock.methods = append(mock.methods, fd)
+				}
+				continue
+			} else if fd.Name.Name == "RunAndReturn" {
+				mc.addReturnFunc(receiverName(fd))
+			}
+		}
+		if gd, ok := decl.(*ast.GenDecl); !ok || gd.Tok != token.IMPORT {
+			retained = append(retained, decl)
+		}
+	}
+	w := &bytes.Buffer{}
+	w.WriteString("package " + mc.file.Name.Name + "\n\n")
+	intfPkg, intf := mc.interfaceType()
+	mc.writeImports(w, intfPkg)
+	for _, name := range mc.order {
+		mc.mocks[name].write(w, intf, mockName)
+	}
+	for _, decl := range retained {
+		w.WriteString("\n")
+		w.Write(mc.source(decl))
+		w.WriteString("\n")
+	}
+	return mc.pruneImports(w.Bytes())
+}
+
+func (mc *mockeryConverter) addMock(name string, doc string) {
+	intf := strings.TrimPrefix(name, "Mock")
+	if m := mockeryIntfRegex.FindStringSubmatch(doc); m != nil {
+		intf = m[1]
+	}
+	mc.mocks[name] = &mockeryMock{
+		name:        name,
+		intf:        intf,
+		methods:     []*ast.FuncDecl{},
+		returnFuncs: map[string]bool{},
+	}
+	mc.order = append(mc.order, name)
+}
+
+// addReturnFunc notes the method of a RunAndReturn receiver (e.g. "MockThing_DoSomething_Call") - so that the regenerated
+// mocked method calls the func returned
+func (mc *mockeryConverter) addReturnFunc(receiver string) {
+	for name, m := range mc.mocks {
+		if method := strings.TrimPrefix(receiver, name+"_"); method != receiver && strings.HasSuffix(method, "_Call") {
+			m.returnFuncs[strings.TrimSuffix(method, "_Call")] = true
+		}
+	}
+}
+
+// isMockDecl determines whether the decl is a mock struct type or a mockery mock constructor (both of which are regenerated)
+func (mc *mockeryConverter) isMockDecl(decl ast.Decl) bool {
+	switch dt := decl.(type) {
+	case *ast.GenDecl:
+		if dt.Tok == token.TYPE && len(dt.Specs) == 1 {
+			_, ok := mc.mocks[dt.Specs[0].(*ast.TypeSpec).Name.Name]
+			return ok
+		}
+	case *ast.FuncDecl:
+		if dt.Recv == nil && strings.HasPrefix(dt.Name.Name, "New") {
+			_, ok := mc.mocks[strings.TrimPrefix(dt.Name.Name, "New")]
+			return ok
+		}
+	}
+	return false
+}
+
+func (mc *mockeryConverter) interfaceType() (pkgPath string, intf string) {
+	intf = mc.opts.Interface
+	if dot := strings.LastIndex(intf, "."); dot != -1 {
+		pkgPath = intf[:dot]
+		pkg, _ := packagePathToPackage(pkgPath)
+		intf = pkg + intf[dot:]
+	}
+	return
+}
+
+func (mc *mockeryConverter) writeImports(w *bytes.Buffer, intfPkg string) {
+	imps := map[string]string{pkgMmock: ""}
+	if intfPkg != "" {
+		imps[intfPkg] = ""
+	}
+	for _, spec := range mc.file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if pkg, _ := packagePathToPackage(path); spec.Name != nil && spec.Name.Name != pkg {
+			imps[path] = spec.Name.Name
+		} else {
+			imps[path] = ""
+		}
+	}
+	lines := make([]string, 0, len(imps))
+	for path, name := range imps {
+		if name != "" {
+			lines = append(lines, "\t"+name+" "+strconv.Quote(path))
+		} else {
+			lines = append(lines, "\t"+strconv.Quote(path))
+		}
+	}
+	sort.Strings(lines)
+	w.WriteString("import (\n" + strings.Join(lines, "\n") + "\n)\n")
+}
+
+// pruneImports removes any imports that are not used by the converted code
+func (mc *mockeryConverter) pruneImports(code []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	edits := make([]edit, 0)
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		pkg, _ := packagePathToPackage(path)
+		if !usesPackage(f, importName(spec, pkg)) {
+			edits = append(edits, wholeLines(code, fset.Position(spec.Pos()).Offset, fset.Position(spec.End()).Offset))
+		}
+	}
+	return format.Source(applyEdits(code, edits))
+}
+
+func (mc *mockeryConverter) source(n ast.Node) []byte {
+	start := n.Pos()
+	if fd, ok := n.(*ast.FuncDecl); ok && fd.Doc != nil {
+		start = fd.Doc.Pos()
+	} else if gd, ok := n.(*ast.GenDecl); ok && gd.Doc != nil {
+		start = gd.Doc.Pos()
+	}
+	return mc.src[mc.fset.Position(start).Offset:mc.fset.Position(n.End()).Offset]
+}
+
+func (m *mockeryMock) write(w *bytes.Buffer, intf string, mockName string) {
+	if intf == "" {
+		w.WriteString(fmt.Sprintf("\n// %s is an mmock mock for the %s type\n", m.name, m.intf))
+	} else {
+		w.WriteString(fmt.Sprintf("\n// %s is an mmock mock for the %s type\n", m.name, intf))
+	}
+	w.WriteString("type " + m.name + " struct {\n\tmmock.MockMethods\n}\n\n")
+	construct := "mmock.NewMock[" + m.name + "]()"
+	if intf != "" {
+		construct = "mmock.NewMockOf[" + m.name + ", " + intf + "]()"
+	}
+	w.WriteString("// New" + m.name + " creates a new instance of " + m.name + ". It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.\n" +
+		"// The first argument is typically a *testing.T value.\n" +
+		"func New" + m.name + "(t interface {\n\t" + mockName + ".TestingT\n\tCleanup(func())\n}) *" + m.name + " {\n" +
+		"\tm := " + construct + "\n" +
+		"\tm.Test(t)\n" +
+		"\tt.Cleanup(func() { m.AssertExpectations(t) })\n" +
+		"\treturn m\n}\n")
+	if intf != "" {
+		w.WriteString("\n// make sure mock implements interface...\nvar _ " + intf + " = &" + m.name + "{}\n")
+	}
+	for _, fd := range m.methods {
+		w.WriteString("\n")
+		writeMockeryMethod(w, m.name, fd, m.returnFuncs[fd.Name.Name])
+	}
+}
+
+func writeMockeryMethod(w *bytes.Buffer, receiver string, fd *ast.FuncDecl, returnFunc bool) {
+	ins := make([]string, 0)
+	variadic := false
+	variadicAny := false
+	for _, p := range fd.Type.Params.List {
+		n := len(p.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			ins = append(ins, typeString(p.Type))
+		}
+		if el, ok := p.Type.(*ast.Ellipsis); ok {
+			variadic = true
+			elt := typeString(el.Elt)
+			variadicAny = elt == "any" || elt == "interface{}"
+		}
+	}
+	outs := make([]string, 0)
+	if fd.Type.Results != nil {
+		for _, r := range fd.Type.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				outs = append(outs, typeString(r.Type))
+			}
+		}
+	}
+	callArgs := make([]string, len(ins))
+	params := make([]string, len(ins))
+	for i, in := range ins {
+		callArgs[i] = fmt.Sprintf("arg%d", i+1)
+		params[i] = callArgs[i] + " " + in
+	}
+	w.WriteString("func (m *" + receiver + ") " + fd.Name.Name + "(" + strings.Join(params, ", ") + ")")
+	if len(outs) == 1 {
+		w.WriteString(" " + outs[0])
+	} else if len(outs) > 1 {
+		w.WriteString(" (" + strings.Join(outs, ", ") + ")")
+	}
+	w.WriteString(" {\n")
+	prefix := "\t"
+	if len(outs) > 0 || returnFunc {
+		prefix = "\tretArgs := "
+	}
+	if variadic {
+		last := callArgs[len(callArgs)-1]
+		w.WriteString("\targs := make([]any, 0)\n")
+		if len(callArgs) > 1 {
+			w.WriteString("\targs = append(args, " + strings.Join(callArgs[:len(callArgs)-1], ", ") + ")\n")
+		}
+		if variadicAny {
+			w.WriteString("\targs = append(args, " + last + "...)\n")
+		} else {
+			w.WriteString("\tfor _, v := range " + last + " {\n\t\targs = append(args, v)\n\t}\n")
+		}
+		w.WriteString(prefix + "m.Called(args...)\n")
+	} else {
+		w.WriteString(prefix + "m.Called(" + strings.Join(callArgs, ", ") + ")\n")
+	}
+	combined, resultFuncs := mockeryResultFuncs(fd)
+	if (returnFunc || combined) && !(len(outs) == 1 && resultFuncs[0]) {
+		writeReturnFuncCall(w, ins, outs, callArgs, variadic)
+	}
+	if len(resultFuncs) > 0 {
+		writeResultFuncCalls(w, ins, outs, callArgs, variadic, resultFuncs)
+	} else if l := len(outs); l > 0 && l <= maxAsN {
+		w.WriteString(fmt.Sprintf("\treturn mmock.As%d[%s](retArgs)\n", l, strings.Join(outs, ", ")))
+	} else if l > maxAsN {
+		rets := make([]string, l)
+		for i, o := range outs {
+			rets[i] = fmt.Sprintf("mmock.As[%s](retArgs, %d)", o, i)
+		}
+		w.WriteString("\treturn " + strings.Join(rets, ", ") + "\n")
+	}
+	w.WriteString("}\n")
+}
+
+// mockeryResultFuncs determines which funcs a mockery mocked method calls to obtain its results - i.e. whether it calls a
+// func for all the results (e.g. set by RunAndReturn) and the indices of the results that it calls a func for
+//
+// (mockery methods check the return args with, e.g., `if rf, ok := ret.Get(0).(func(string) int); ok {`)
+func mockeryResultFuncs(fd *ast.FuncDecl) (combined bool, resultFuncs map[int]bool) {
+	resultFuncs = map[int]bool{}
+	if fd.Body == nil {
+		return
+	}
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if ta, ok := n.(*ast.TypeAssertExpr); ok {
+			if ft, ok := ta.Type.(*ast.FuncType); ok {
+				if idx, ok := retGetIndex(ta.X); ok {
+					if ft.Results != nil && ft.Results.NumFields() > 1 {
+						combined = true
+					} else if ft.Results != nil && ft.Results.NumFields() == 1 {
+						resultFuncs[idx] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	return
+}
+
+// retGetIndex returns the index of a mockery `ret.Get(n)` call
+func retGetIndex(expr ast.Expr) (int, bool) {
+	if ce, ok := expr.(*ast.CallExpr); ok && len(ce.Args) == 1 {
+		if sel, ok := ce.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Get" {
+			if lit, ok := ce.Args[0].(*ast.BasicLit); ok && lit.Kind == token.INT {
+				idx, err := strconv.Atoi(lit.Value)
+				return idx, err == nil
+			}
+		}
+	}
+	return 0, false
+}
+
+// writeResultFuncCalls writes the results of a mocked method - calling the func set as the return value of those results
+// that mockery calls a func for (see mockeryResultFuncs)
+func writeResultFuncCalls(w *bytes.Buffer, ins []string, outs []string, callArgs []string, variadic bool, resultFuncs map[int]bool) {
+	rets := make([]string, len(outs))
+	for i, out := range outs {
+		rets[i] = fmt.Sprintf("r%d", i)
+		if resultFuncs[i] {
+			w.WriteString(fmt.Sprintf("\tvar %s %s\n", rets[i], out))
+			w.WriteString(fmt.Sprintf("\tif rf, ok := retArgs.Get(%d).(%s); ok {\n", i, funcTypeString(ins, outs[i:i+1])))
+			w.WriteString(fmt.Sprintf("\t\t%s = %s\n\t} else {\n", rets[i], returnFuncCall(callArgs, variadic)))
+			w.WriteString(fmt.Sprintf("\t\t%s = mmock.As[%s](retArgs, %d)\n\t}\n", rets[i], out, i))
+		} else {
+			w.WriteString(fmt.Sprintf("\t%s := mmock.As[%s](retArgs, %d)\n", rets[i], out, i))
+		}
+	}
+	w.WriteString("\treturn " + strings.Join(rets, ", ") + "\n")
+}
+
+// writeReturnFuncCall writes the call of a func set as the return value for all results (e.g. by the RunAndReturn of a
+// mockery EXPECT() helper)
+func writeReturnFuncCall(w *bytes.Buffer, ins []string, outs []string, callArgs []string, variadic bool) {
+	fnType := funcTypeString(ins, outs)
+	call := returnFuncCall(callArgs, variadic)
+	w.WriteString("\tif len(retArgs) > 0 {\n\t\tif rf, ok := retArgs[0].(" + fnType + "); ok {\n")
+	if len(outs) > 0 {
+		w.WriteString("\t\t\treturn " + call + "\n")
+	} else {
+		w.WriteString("\t\t\t" + call + "\n\t\t\treturn\n")
+	}
+	w.WriteString("\t\t}\n\t}\n")
+}
+
+func funcTypeString(ins []string, outs []string) string {
+	fnType := "func(" + strings.Join(ins, ", ") + ")"
+	if len(outs) == 1 {
+		fnType += " " + outs[0]
+	} else if len(outs) > 1 {
+		fnType += " (" + strings.Join(outs, ", ") + ")"
+	}
+	return fnType
+}
+
+func returnFuncCall(callArgs []string, variadic bool) string {
+	call := "rf(" + strings.Join(callArgs, ", ")
+	if variadic {
+		call += "..."
+	}
+	return call + ")"
+}
+
+func typeString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func embedsMock(st *ast.StructType, mockName string) bool {
+	for _, field := range st.Fields.List {
+		if sel, ok := field.Type.(*ast.SelectorExpr); ok && len(field.Names) == 0 && sel.Sel.Name == "Mock" {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == mockName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func receiverName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return ""
+	}
+	t := fd.Recv.List[0].Type
+	if st, ok := t.(*ast.StarExpr); ok {
+		t = st.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func packagePathToPackage(pp string) (string, bool) {
+	if pp == "" {
+		return pp, true
+	}
+	pts := strings.Split(pp, "/")
+	if len(pts) == 1 {
+		return pts[0], true
+	}
+	pkg := pts[len(pts)-1]
+	if strings.HasPrefix(pkg, "v") && len(pkg) > 1 && pkg[1] >= '0' && pkg[1] <= '9' {
+		pkg = pts[len(pts)-2]
+	}
+	return pkg, false
+}
@@ -0,0 +1,317 @@
+package migrate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// methodRewrite describes how a string named mock.Mock method is rewritten
+type methodRewrite struct {
+	name    string
+	nameArg int
+}
+
+var methodRewrites = map[string]methodRewrite{
+	"On":                  {name: "OnMethod", nameArg: 0},
+	"AssertCalled":        {name: "AssertMethodCalled", nameArg: 1},
+	"AssertNotCalled":     {name: "AssertMethodNotCalled", nameArg: 1},
+	"AssertNumberOfCalls": {name: "AssertNumberOfMethodCalls", nameArg: 1},
+}
+
+type edit struct {
+	start int
+	end   int
+	text  string
+}
+
+type fileRewriter struct {
+	fset     *token.FileSet
+	cp       *checkedPackage
+	file     *ast.File
+	mocks    map[string]bool
+	src      []byte
+	edits    []edit
+	warnings []string
+	rewrites int
+	embeds   int
+}
+
+func newFileRewriter(fset *token.FileSet, cp *checkedPackage, file *ast.File, mocks map[string]bool) *fileRewriter {
+	return &fileRewriter{
+		fset:     fset,
+		cp:       cp,
+		file:     file,
+		mocks:    mocks,
+		edits:    []edit{},
+		warnings: []string{},
+	}
+}
+
+func (fr *fileRewriter) rewrite() (*FileChange, []string, error) {
+	filename := fr.fset.File(fr.file.Pos()).Name()
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	fr.src = src
+	ast.Inspect(fr.file, func(n ast.Node) bool {
+		switch nt := n.(type) {
+		case *ast.CallExpr:
+			fr.rewriteCall(nt)
+		case *ast.StructType:
+			fr.rewriteEmbeds(nt)
+		}
+		return true
+	})
+	if len(fr.edits) == 0 {
+		return nil, fr.warnings, nil
+	}
+	migrated, err := fr.fixImports(applyEdits(src, fr.edits))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return &FileChange{
+		Filename: filename,
+		Original: src,
+		Migrated: migrated,
+		Rewrites: fr.rewrites + fr.embeds,
+	}, fr.warnings, nil
+}
+
+func (fr *fileRewriter) rewriteCall(call *ast.CallExpr) {
+	fun, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	sel := fr.cp.info.Selections[fun]
+	if sel == nil || sel.Kind() != types.MethodVal {
+		return
+	}
+	mr, ok := methodRewrites[fun.Sel.Name]
+	if !ok || !isMockMethod(sel.Obj()) || len(call.Args) <= mr.nameArg {
+		return
+	}
+	if ok, reason := fr.isMigratable(sel); !ok {
+		fr.warn(call, "not migrated, "+reason)
+		return
+	}
+	if !isPure(fun.X) {
+		fr.warn(call, "not migrated, receiver expression is not a simple variable or field")
+		return
+	}
+	nameArg := call.Args[mr.nameArg]
+	tv := fr.cp.info.Types[nameArg]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		fr.warn(call, "not migrated, method name is not a constant string")
+		return
+	}
+	methodName := constant.StringVal(tv.Value)
+	obj, _, _ := types.LookupFieldOrMethod(sel.Recv(), true, fr.cp.pkg, methodName)
+	if obj == nil {
+		fr.warn(call, fmt.Sprintf("not migrated, method %q does not exist on type %s", methodName, sel.Recv().String()))
+		return
+	}
+	method, ok := obj.(*types.Func)
+	if !ok {
+		fr.warn(call, fmt.Sprintf("not migrated, %q is not a method of type %s", methodName, sel.Recv().String()))
+		return
+	}
+	if fun.Sel.Name == "AssertNotCalled" && len(call.Args)-2 < method.Type().(*types.Signature).Params().Len() {
+		// mmock pads unspecified args with mock.Anything - so the meaning of the assertion changes...
+		fr.warn(call, "migrated, but now asserts that the method was not called with any value for the unspecified args")
+	}
+	fr.addEdit(fun.Sel, mr.name)
+	fr.addEdit(nameArg, fr.source(fun.X)+"."+methodName)
+	fr.rewrites++
+}
+
+// isMigratable determines whether the mock.Mock that the method is selected from is, or will be, an mmock.MockMethods
+func (fr *fileRewriter) isMigratable(sel *types.Selection) (bool, string) {
+	path := sel.Index()
+	if len(path) < 2 {
+		return false, "method is called directly on mock.Mock"
+	}
+	t := sel.Recv()
+	var owner *types.Named
+	for _, idx := range path[:len(path)-1] {
+		nt, ok := deref(t).(*types.Named)
+		if !ok {
+			return false, "mock.Mock is not embedded in a named type"
+		}
+		st, ok := nt.Underlying().(*types.Struct)
+		if !ok {
+			return false, "mock.Mock is not embedded in a struct"
+		}
+		owner = nt
+		t = st.Field(idx).Type()
+	}
+	if isNamed(owner, pkgMmock, "MockMethods") || fr.mocks[typeKey(owner.Obj())] {
+		return true, ""
+	}
+	return false, fmt.Sprintf("type %s (which embeds mock.Mock) is not being migrated", owner.String())
+}
+
+func (fr *fileRewriter) rewriteEmbeds(st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 && isNamed(fr.cp.info.TypeOf(field.Type), pkgTestifyMock, "Mock") {
+			fr.addEdit(field.Type, fr.mmockName()+".MockMethods")
+			fr.embeds++
+		}
+	}
+}
+
+func (fr *fileRewriter) mmockName() string {
+	for _, spec := range fr.file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == pkgMmock && spec.Name != nil {
+			return spec.Name.Name
+		}
+	}
+	return "mmock"
+}
+
+// fixImports adds the mmock import (if needed) and removes the testify mock import (if no longer used)
+func (fr *fileRewriter) fixImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var mockDecl *ast.GenDecl
+	var mockSpec *ast.ImportSpec
+	hasMmock := false
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			for _, spec := range gd.Specs {
+				is := spec.(*ast.ImportSpec)
+				switch path, _ := strconv.Unquote(is.Path.Value); path {
+				case pkgTestifyMock:
+					mockDecl, mockSpec = gd, is
+				case pkgMmock:
+					hasMmock = true
+				}
+			}
+		}
+	}
+	edits := make([]edit, 0, 2)
+	offset := func(p token.Pos) int {
+		return fset.Position(p).Offset
+	}
+	addMmock := !hasMmock && fr.embeds > 0
+	removeMock := mockSpec != nil && !usesPackage(f, importName(mockSpec, "mock"))
+	imp := strconv.Quote(pkgMmock)
+	switch {
+	case addMmock && removeMock:
+		edits = append(edits, edit{start: offset(mockSpec.Pos()), end: offset(mockSpec.End()), text: imp})
+	case addMmock && mockSpec == nil:
+		edits = append(edits, edit{start: offset(f.Name.End()), end: offset(f.Name.End()), text: "\n\nimport " + imp})
+	case addMmock && mockDecl.Lparen.IsValid():
+		edits = append(edits, edit{start: offset(mockSpec.Pos()), end: offset(mockSpec.Pos()), text: imp + "\n\t"})
+	case addMmock:
+		edits = append(edits, edit{start: offset(mockDecl.Pos()), end: offset(mockDecl.Pos()), text: "import " + imp + "\n"})
+	case removeMock && mockDecl.Lparen.IsValid() && len(mockDecl.Specs) > 1:
+		edits = append(edits, wholeLines(src, offset(mockSpec.Pos()), offset(mockSpec.End())))
+	case removeMock:
+		edits = append(edits, wholeLines(src, offset(mockDecl.Pos()), offset(mockDecl.End())))
+	}
+	return format.Source(applyEdits(src, edits))
+}
+
+func (fr *fileRewriter) addEdit(n ast.Node, text string) {
+	fr.edits = append(fr.edits, edit{
+		start: fr.fset.Position(n.Pos()).Offset,
+		end:   fr.fset.Position(n.End()).Offset,
+		text:  text,
+	})
+}
+
+func (fr *fileRewriter) source(n ast.Node) string {
+	return string(fr.src[fr.fset.Position(n.Pos()).Offset:fr.fset.Position(n.End()).Offset])
+}
+
+func (fr *fileRewriter) warn(n ast.Node, reason string) {
+	fr.warnings = append(fr.warnings, fmt.Sprintf("%s: %s - %s", fr.fset.Position(n.Pos()), fr.source(n), reason))
+}
+
+func applyEdits(src []byte, edits []edit) []byte {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+	result := append([]byte{}, src...)
+	for _, e := range edits {
+		result = append(result[:e.start], append([]byte(e.text), result[e.end:]...)...)
+	}
+	return result
+}
+
+// wholeLines creates an edit that removes the lines spanning start to end
+func wholeLines(src []byte, start int, end int) edit {
+	for start > 0 && src[start-1] != '\n' {
+		start--
+	}
+	for end < len(src) && src[end] != '\n' {
+		end++
+	}
+	if end < len(src) {
+		end++
+	}
+	return edit{start: start, end: end}
+}
+
+func isMockMethod(obj types.Object) bool {
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Type().(*types.Signature)
+	return sig.Recv() != nil && isNamed(deref(sig.Recv().Type()), pkgTestifyMock, "Mock")
+}
+
+func deref(t types.Type) types.Type {
+	if pt, ok := t.(*types.Pointer); ok {
+		return pt.Elem()
+	}
+	return t
+}
+
+// isPure determines whether an expression can be safely repeated (i.e. it is an identifier or selection of identifiers)
+func isPure(expr ast.Expr) bool {
+	switch et := expr.(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		return isPure(et.X)
+	case *ast.ParenExpr:
+		return isPure(et.X)
+	case *ast.StarExpr:
+		return isPure(et.X)
+	}
+	return false
+}
+
+func importName(spec *ast.ImportSpec, def string) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	return def
+}
+
+func usesPackage(f *ast.File, name string) bool {
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == name && id.Obj == nil {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
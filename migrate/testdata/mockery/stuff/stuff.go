@@ -0,0 +1,14 @@
+// Package stuff is the mocked package of the mockery testdata (used to type check the converted mocks)
+package stuff
+
+import "context"
+
+type SomeStruct struct {
+	Value string
+}
+
+type Thing interface {
+	DoSomething(ctx context.Context, a string) (*SomeStruct, error)
+	Log(format string, args ...interface{})
+	Sum(nums ...int) int
+}
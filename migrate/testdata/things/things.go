@@ -0,0 +1,24 @@
+package things
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type Thing interface {
+	DoSomething(a string) (string, error)
+	DoSomethingElse(a int) error
+}
+
+type MockThing struct {
+	mock.Mock
+}
+
+func (m *MockThing) DoSomething(a string) (string, error) {
+	args := m.Called(a)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockThing) DoSomethingElse(a int) error {
+	args := m.Called(a)
+	return args.Error(0)
+}
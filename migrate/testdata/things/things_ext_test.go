@@ -0,0 +1,13 @@
+package things_test
+
+import (
+	"github.com/go-andiamo/mmock/migrate/testdata/things"
+	"testing"
+)
+
+func TestThingExternal(t *testing.T) {
+	m := &things.MockThing{}
+	m.On("DoSomething", "a").Return("a", nil)
+	_, _ = m.DoSomething("a")
+	m.AssertExpectations(t)
+}
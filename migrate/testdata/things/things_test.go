@@ -0,0 +1,27 @@
+package things
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+const methodName = "DoSomethingElse"
+
+func TestThing(t *testing.T) {
+	m := &MockThing{}
+	m.On("DoSomething", mock.Anything).Return("a", nil)
+	m.On(methodName, 1).Return(nil)
+	_, err := m.DoSomething("x")
+	assert.NoError(t, err)
+	m.AssertCalled(t, "DoSomething", "x")
+	m.AssertNotCalled(t, "DoSomethingElse")
+	m.AssertNumberOfCalls(t, "DoSomething", 1)
+	newMock().On("DoSomething").Return("", nil)
+	m.On("Unknown").Return(nil)
+	m.Mock.On("DoSomething").Return("", nil)
+}
+
+func newMock() *MockThing {
+	return &MockThing{}
+}
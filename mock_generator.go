@@ -203,6 +203,7 @@ func (f mockFunc) writeOutArgs(w *writer) {
 }
 
 const (
+	maxAsN          = 8
 	returnVarName   = "retArgs"
 	returnVarAssign = "\t" + returnVarName + " := "
 )
@@ -243,7 +244,7 @@ func (f mockFunc) writeBody(callArgs []string, w *writer) {
 
 func (f mockFunc) writeReturn(w *writer) {
 	w.write("\treturn ")
-	if l := len(f.outs); l <= 4 {
+	if l := len(f.outs); l <= maxAsN {
 		oTypes := make([]string, l)
 		for i, a := range f.outs {
 			oTypes[i] = a.fullName()
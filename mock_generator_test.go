@@ -166,7 +166,7 @@ func (m *MockThingy) DoSomethingVars(arg1 *map[string]any, arg2 ...any) *map[*mm
 
 func (m *MockThingy) ManyReturns() (string, int, int64, float64, bool, []string, error) {
 	retArgs := m.Called()
-	return mmock.As[string](retArgs, 0), mmock.As[int](retArgs, 1), mmock.As[int64](retArgs, 2), mmock.As[float64](retArgs, 3), mmock.As[bool](retArgs, 4), mmock.As[[]string](retArgs, 5), mmock.As[error](retArgs, 6)
+	return mmock.As7[string, int, int64, float64, bool, []string, error](retArgs)
 }
 
 func (m *MockThingy) ReturnSomething() error {
@@ -247,7 +247,7 @@ func (m *MockThingy) DoSomethingVars(arg1 *map[string]any, arg2 ...any) *map[*So
 
 func (m *MockThingy) ManyReturns() (string, int, int64, float64, bool, []string, error) {
 	retArgs := m.Called()
-	return mmock.As[string](retArgs, 0), mmock.As[int](retArgs, 1), mmock.As[int64](retArgs, 2), mmock.As[float64](retArgs, 3), mmock.As[bool](retArgs, 4), mmock.As[[]string](retArgs, 5), mmock.As[error](retArgs, 6)
+	return mmock.As7[string, int, int64, float64, bool, []string, error](retArgs)
 }
 
 func (m *MockThingy) ReturnSomething() error {
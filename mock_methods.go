@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -22,11 +23,19 @@ import (
 //	}
 //	myMock := NewMock[MockedSomething]()
 func NewMock[T any]() *T {
+	r, msg := newMock[T]()
+	if r == nil {
+		panic(msg)
+	}
+	return r
+}
+
+func newMock[T any]() (*T, string) {
 	r := new(T)
 	if !setMockOf(r) {
-		panic(fmt.Sprintf("type '%s' is not MockMethods (add field mmock.MockMethods)", reflect.TypeOf(r).Elem().String()))
+		return nil, fmt.Sprintf("type '%s' is not MockMethods (add field mmock.MockMethods)", reflect.TypeOf(r).Elem().String())
 	}
-	return r
+	return r, ""
 }
 
 func setMockOf(mocked any) (ok bool) {
@@ -53,14 +62,57 @@ func setMockOf(mocked any) (ok bool) {
 //	}
 //	myMock := NewMockOf[MockedSomething, my]()
 func NewMockOf[T any, I any]() *T {
-	r := NewMock[T]()
+	r, msg := newMockOf[T, I]()
+	if r == nil {
+		panic(msg)
+	}
+	return r
+}
+
+func newMockOf[T any, I any]() (*T, string) {
+	r, msg := newMock[T]()
+	if r == nil {
+		return nil, msg
+	}
 	if _, ok := interface{}(r).(I); !ok {
 		i := new(I)
-		panic(fmt.Sprintf("type '%s' does not implement interface '%s'", reflect.TypeOf(r).Elem().String(), reflect.TypeOf(i).Elem().Name()))
+		return nil, fmt.Sprintf("type '%s' does not implement interface '%s'", reflect.TypeOf(r).Elem().String(), reflect.TypeOf(i).Elem().Name())
 	}
+	return r, ""
+}
+
+// NewMockT creates a new mock of a specified type that is bound to a test (or benchmark or fuzz target)
+//
+// same as NewMockOf except that failures (e.g. unexpected calls) are reported to the test rather than panicking, and
+// the mock's expectations are automatically asserted when the test finishes (using tb.Cleanup)
+//
+// Example usage:
+//
+//	func TestSomething(t *testing.T) {
+//	  myMock := NewMockT[MockedSomething, my](t)
+//	  myMock.OnMethod(myMock.SomeMethod)
+//	  ...
+//	}
+func NewMockT[T any, I any](tb testing.TB) *T {
+	tb.Helper()
+	r, msg := newMockOf[T, I]()
+	if r == nil {
+		tb.Fatal(msg)
+		return nil
+	}
+	bindTest(r, tb)
 	return r
 }
 
+func bindTest(mocked any, tb testing.TB) {
+	if msu, ok := mocked.(mockSetup); ok {
+		msu.Test(tb)
+		tb.Cleanup(func() {
+			msu.AssertExpectations(tb)
+		})
+	}
+}
+
 type Spying interface {
 	// SetSpyOf sets the mock to be a spy mock
 	//
@@ -73,6 +125,8 @@ type Spying interface {
 type mockSetup interface {
 	Spying
 	setMockOf(mocked any)
+	Test(t mock.TestingT)
+	AssertExpectations(t mock.TestingT) bool
 }
 
 func (mm *MockMethods) setMockOf(mocked any) {
@@ -80,14 +134,79 @@ func (mm *MockMethods) setMockOf(mocked any) {
 }
 
 func (mm *MockMethods) SetSpyOf(wrapped any) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
 	mm.wrapped = wrapped
 }
 
+// Test binds the mock to a test - failures (e.g. unexpected calls) are then reported to the test rather than panicking
+//
+// If the test supports cleanup (e.g. testing.T), any injected faults and chaos are logged when the test finishes (if it
+// has failed) - even if AssertExpectations is not called
+//
+// Note: NewMockT and NewSpyMockT bind the mock to the test (and also assert the mock's expectations when the test finishes)
+func (mm *MockMethods) Test(t mock.TestingT) {
+	mm.lock.Lock()
+	mm.test = t
+	mm.lock.Unlock()
+	if c, ok := t.(interface{ Cleanup(func()) }); ok {
+		c.Cleanup(func() {
+			mm.reportFaults(t)
+		})
+	}
+}
+
+// fail reports a failure to the bound test (see Test) - or panics if the mock is not bound to a test
+func (mm *MockMethods) fail(format string, args ...any) {
+	mm.lock.Lock()
+	t := mm.test
+	mm.lock.Unlock()
+	if t == nil {
+		panic(fmt.Sprintf(format, args...))
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf(format, args...)
+	t.FailNow()
+}
+
 // MockMethods is the replacement for mock.Mock
 type MockMethods struct {
 	mock.Mock
-	mockOf  any
-	wrapped any
+	mockOf     any
+	wrapped    any
+	test       mock.TestingT
+	nice       bool
+	strict     bool
+	forbidden  map[string]bool
+	violations []string
+	// the expected calls set up on the mock (in the order they were set up)
+	expectations []*Call
+	// the testify expected calls set up only to record unexpected calls (see recordCall)
+	recorded    map[*mock.Call]bool
+	lock        sync.Mutex
+	invocations map[*any]*invocation
+	records     []*CallRecord
+	verified    map[uint64]bool
+	ordering    *orderedGroup
+	faults      []*FaultPolicy
+	// all fault policies attached to the mock (or its expected calls) - reported on failure
+	faultPolicies []*FaultPolicy
+	chaos         *Chaos
+	// whether the fault policies and chaos have been logged for a failed test (see reportFaults)
+	faultsReported bool
+	clock          Clock
+}
+
+// invocation is an in-progress call of a mocked method
+type invocation struct {
+	returns    mock.Arguments
+	hasReturns bool
+	matched    bool  // whether the call was matched to an expected call (or recorded as a spy/nice call)
+	simulated  bool  // whether the call is simulating a panic or runtime.Goexit (see Call.Panic)
+	call       *Call // the expected call that the call was matched to (nil if not set up by OnMethod)
+	record     *CallRecord
 }
 
 func (mm *MockMethods) Called(arguments ...interface{}) mock.Arguments {
@@ -100,51 +219,236 @@ func (mm *MockMethods) Called(arguments ...interface{}) mock.Arguments {
 }
 
 func (mm *MockMethods) MethodCalled(methodName string, arguments ...interface{}) (result mock.Arguments) {
-	if mm.wrapped != nil {
-		defer func() {
-			if r := recover(); r != nil {
-				// assuming that panic was raised by testify Mock.MethodCalled?
-				if msg, ok := r.(string); ok && strings.Contains(msg, "mock:") {
-					result = mm.callWrapped(methodName, arguments...)
-				} else {
-					panic(r) // some other panic?
-				}
-			}
-		}()
+	if mm.isForbidden(methodName) {
+		mm.violation("mmock: forbidden method called: %s()", methodName)
+		return nil
+	} else if outOfOrder := mm.checkOrder(methodName, arguments); outOfOrder != "" {
+		mm.fail("%s", outOfOrder)
+		return nil
 	}
+	arguments, inv := mm.startInvocation(methodName, arguments)
+	defer mm.endInvocation(arguments, inv)
+	defer func() {
+		r := recover()
+		if inv.simulated {
+			// the call was matched - but is simulating a panic (or runtime.Goexit - in which case r is nil and the goroutine continues to exit)
+			inv.matched = true
+			mm.finishRecord(inv.record, nil)
+			if r != nil {
+				panic(r)
+			}
+			return
+		}
+		if r != nil {
+			// assuming that panic was raised by testify Mock.MethodCalled?
+			if msg, ok := r.(string); ok && isTestifyFailure(msg) {
+				result = mm.unexpectedCall(methodName, msg, arguments)
+			} else {
+				inv.matched = true // the call was matched - but panicked
+				mm.finishRecord(inv.record, nil)
+				panic(r) // some other panic?
+			}
+		}
+		if inv.matched {
+			result = mm.applyFaults(methodName, inv, result)
+			mm.finishRecord(inv.record, result)
+		}
+	}()
 	result = mm.Mock.MethodCalled(methodName, arguments...)
+	inv.matched = true
+	if inv.hasReturns {
+		result = inv.returns
+	}
 	return
 }
 
-func (mm *MockMethods) callWrapped(methodName string, arguments ...interface{}) (result mock.Arguments) {
-	ul := reflect.ValueOf(mm.wrapped)
+// isTestifyFailure determines whether a panic message was raised by testify (rather than by mmock or the mocked method)
+func isTestifyFailure(msg string) bool {
+	return strings.Contains(msg, "mock:") && !strings.HasPrefix(msg, "mmock:")
+}
+
+// startInvocation registers an in-progress call of a mocked method - and records the call (so that a call that is still
+// in progress, e.g. blocked, can be asserted)
+//
+// The arguments are copied - so that the invocation can be identified by the arguments
+// slice that testify passes to Call.RunFn
+func (mm *MockMethods) startInvocation(methodName string, arguments []any) ([]any, *invocation) {
+	arguments = append(make([]any, 0, len(arguments)+1), arguments...)
+	clock := mm.getClock()
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	// the record is created with the lock held - so that the mock's records are in sequence order...
+	inv := &invocation{
+		record: newCallRecord(methodName, arguments, clock.Now()),
+	}
+	if mm.invocations == nil {
+		mm.invocations = map[*any]*invocation{}
+	}
+	mm.invocations[&arguments[:1][0]] = inv
+	callsInProgress.Store(&arguments[:1][0], methodName)
+	mm.records = append(mm.records, inv.record)
+	return arguments, inv
+}
+
+// endInvocation deregisters an in-progress call - removing the record of the call if it was not matched (i.e. it failed
+// as an unexpected call)
+func (mm *MockMethods) endInvocation(arguments []any, inv *invocation) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	delete(mm.invocations, &arguments[:1][0])
+	callsInProgress.Delete(&arguments[:1][0])
+	if !inv.matched {
+		for i, record := range mm.records {
+			if record == inv.record {
+				mm.records = append(mm.records[:i], mm.records[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// callsInProgress is the method names of in-progress calls (of all mocks) - keyed the same as MockMethods.invocations (so
+// that As can name the method of the args passed to a Run func)
+var callsInProgress sync.Map
+
+// invocationOf returns the in-progress invocation for the arguments passed to Call.RunFn
+func (mm *MockMethods) invocationOf(arguments mock.Arguments) *invocation {
+	if cap(arguments) == 0 {
+		return nil
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.invocations[&arguments[:1][0]]
+}
+
+// setReturns overrides the return arguments of an in-progress invocation
+func (mm *MockMethods) setReturns(arguments mock.Arguments, returns mock.Arguments) {
+	if inv := mm.invocationOf(arguments); inv != nil {
+		inv.returns, inv.hasReturns = returns, true
+	}
+}
+
+// setMatchedCall sets the expected call that an in-progress invocation was matched to
+func (mm *MockMethods) setMatchedCall(arguments mock.Arguments, call *Call) {
+	if inv := mm.invocationOf(arguments); inv != nil {
+		inv.call = call
+	}
+}
+
+// setSimulated marks an in-progress invocation as simulating a panic or runtime.Goexit
+func (mm *MockMethods) setSimulated(arguments mock.Arguments) {
+	if inv := mm.invocationOf(arguments); inv != nil {
+		inv.simulated = true
+	}
+}
+
+// unexpectedCall handles a call for which testify Mock.MethodCalled could not find a matching expected call
+func (mm *MockMethods) unexpectedCall(methodName string, msg string, arguments []any) mock.Arguments {
+	if strings.Contains(msg, "Must not be called before") {
+		// the call was expected - but out of order
+		mm.fail("%s", msg)
+		return nil
+	}
+	mm.lock.Lock()
+	wrapped, nice, strict := mm.wrapped, mm.nice, mm.strict
+	mm.lock.Unlock()
+	if strict {
+		mm.violation("mmock: strict mock unexpected call: %s()\n%s", methodName, mm.unmatchedCallReport(methodName, msg, arguments))
+		return nil
+	} else if wrapped != nil {
+		return mm.callWrapped(wrapped, methodName, arguments...)
+	} else if nice {
+		return mm.callNice(methodName, msg, arguments)
+	}
+	mm.fail("%s", mm.unmatchedCallReport(methodName, msg, arguments))
+	return nil
+}
+
+func (mm *MockMethods) callWrapped(wrapped any, methodName string, arguments ...interface{}) (result mock.Arguments) {
+	ul := reflect.ValueOf(wrapped)
 	m := ul.MethodByName(methodName)
 	if !m.IsValid() {
-		panic(fmt.Sprintf("spy mock .Wrapped does not implement method '%s'", methodName))
+		mm.fail("spy mock .Wrapped does not implement method '%s'", methodName)
+		return nil
 	}
-	// simulate mock method called by first ensuring that .On() has been set-up...
+	// simulate mock method called...
 	// this is so that methods that weren't mocked using .On but called directly into wrapped can still be asserted to have been called
-	outs := make([]any, m.Type().NumOut()) // don't care about the actual return args because they'll never get used
-	mm.Mock.On(methodName, arguments...).Once().Return(outs...)
-	mm.Mock.MethodCalled(methodName, arguments...)
+	mm.recordCall(methodName, arguments, make([]any, m.Type().NumOut())) // don't care about the actual return args because they'll never get used
 	// now call the actual underlying wrapped...
 	argVs := make([]reflect.Value, len(arguments))
 	for i, v := range arguments {
 		argVs[i] = reflect.ValueOf(v)
 	}
-	rArgs := m.Call(argVs)
+	mm.lock.Lock()
+	chaos := mm.chaos
+	mm.lock.Unlock()
+	rArgs := chaos.call(methodName, m, argVs, mm.getClock())
 	for _, ra := range rArgs {
 		result = append(result, ra.Interface())
 	}
 	return
 }
 
+func (mm *MockMethods) callNice(methodName string, msg string, arguments []any) mock.Arguments {
+	if mm.mockOf == nil {
+		mm.fail("nice mock cannot determine the results of method '%s' (use NewMock to create the mock)\n%s", methodName, msg)
+		return nil
+	}
+	m, _ := reflect.TypeOf(mm.mockOf).MethodByName(methodName)
+	outs := make([]any, m.Type.NumOut())
+	for i := range outs {
+		outs[i] = reflect.Zero(m.Type.Out(i)).Interface()
+	}
+	mm.recordCall(methodName, arguments, outs)
+	return outs
+}
+
+// recordCall records a call (that was not expected) so that it can still be asserted as having been called
+//
+// it does this by setting up a one-time expected call (for the actual args) and then calling it
+func (mm *MockMethods) recordCall(methodName string, arguments []any, returns []any) {
+	expArgs := make([]any, len(arguments))
+	for i, arg := range arguments {
+		if arg != nil && reflect.TypeOf(arg).Kind() == reflect.Func {
+			// testify does not allow funcs in expected args...
+			expArgs[i] = mock.Anything
+		} else {
+			expArgs[i] = arg
+		}
+	}
+	call := mm.Mock.On(methodName, expArgs...).Once().Return(returns...)
+	mm.lock.Lock()
+	if mm.recorded == nil {
+		mm.recorded = map[*mock.Call]bool{}
+	}
+	mm.recorded[call] = true
+	mm.lock.Unlock()
+	mm.Mock.MethodCalled(methodName, arguments...)
+	if inv := mm.invocationOf(arguments); inv != nil {
+		inv.matched = true
+	}
+}
+
+// SetNice sets whether the mock is 'nice' (lenient)
+//
+// When a mock is nice, calls that have not been expected (using On or OnMethod) return zero values rather than
+// failing - the calls are still recorded (so they can be asserted with AssertMethodCalled etc.) but are not
+// treated as expectations that must be met
+//
+// Note: a spy mock (see SetSpyOf) calls the wrapped implementation instead - regardless of whether it is nice
+func (mm *MockMethods) SetNice(nice bool) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.nice = nice
+}
+
 // OnAllMethods setups expected calls on every method of the mock
 //
 // Use the errs arg to specify that methods that return an error should return an error when called
 func (mm *MockMethods) OnAllMethods(errs bool) {
 	if mm.mockOf == nil {
-		panic("cannot mock all methods")
+		mm.fail("cannot mock all methods")
+		return
 	}
 	exms := excludeMethods()
 	to := reflect.TypeOf(mm.mockOf)
@@ -159,25 +463,42 @@ func (mm *MockMethods) OnAllMethods(errs bool) {
 
 // OnMethod is the same as Mock.On() (https://pkg.go.dev/github.com/stretchr/testify/mock#Call.On)
 //
-// Except the method can be specified by func pointer or name
+// # Except the method can be specified by func pointer or name
+//
+// The arguments are checked against the method signature - and panics if there are too many arguments or
+// any argument (other than argument matchers - e.g. mock.Anything) is not assignable to the method arg type
+//
+// Any captors (see Captor) in the arguments match any value (of the captor type) and capture the args of the matched calls
 //
 //go:noinline
-func (mm *MockMethods) OnMethod(method any, arguments ...any) *mock.Call {
-	methodName, argCount := mm.getMethodNameAndNumArgs(method)
-	for i := argCount - len(arguments); i > 0; i-- {
+func (mm *MockMethods) OnMethod(method any, arguments ...any) *Call {
+	methodName, mt := mm.getMethod(method)
+	mm.validateArguments(methodName, mt, arguments)
+	for i := numArgs(mt) - len(arguments); i > 0; i-- {
 		arguments = append(arguments, mock.Anything)
 	}
-	return mm.Mock.On(methodName, arguments...)
+	arguments, captors := replaceCaptors(arguments)
+	call := mm.Mock.On(methodName, arguments...)
+	return newCall(mm, call, mt, captors, callerSource())
 }
 
 // AssertNumberOfMethodCalls is the same as Mock.AssertNumberOfCalls() (https://pkg.go.dev/github.com/stretchr/testify/mock#Mock.AssertNumberOfCalls)
 //
-// Except the method can be specified by func pointer or name
+// Except the method can be specified by func pointer or name - and the calls are counted from the mock's call records (see CallsOf)
 //
 //go:noinline
-func (mm *MockMethods) AssertNumberOfMethodCalls(t *testing.T, method any, expectedCalls int) bool {
-	methodName, _ := mm.getMethodNameAndNumArgs(method)
-	return mm.Mock.AssertNumberOfCalls(t, methodName, expectedCalls)
+func (mm *MockMethods) AssertNumberOfMethodCalls(t mock.TestingT, method any, expectedCalls int) bool {
+	methodName, _ := mm.getMethod(method)
+	calls := mm.callsOf(methodName)
+	if len(calls) == expectedCalls {
+		mm.verify(calls...)
+		return true
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf("mmock: expected %s() to be called %d times but it was called %d times", methodName, expectedCalls, len(calls))
+	return false
 }
 
 // AssertMethodCalled is the same as Mock.AssertCalled() (https://pkg.go.dev/github.com/stretchr/testify/mock#Mock.AssertCalled)
@@ -187,13 +508,41 @@ func (mm *MockMethods) AssertNumberOfMethodCalls(t *testing.T, method any, expec
 // Also, if the number of arguments specified is less than the expected args of the method then
 // the arguments is padded with mock.Anything
 //
+// Any captors (see Captor) in the arguments capture the args of the matching calls (calls already captured by the same
+// captor in a previous assertion are not captured again)
+//
 //go:noinline
-func (mm *MockMethods) AssertMethodCalled(t *testing.T, method any, arguments ...any) bool {
+func (mm *MockMethods) AssertMethodCalled(t mock.TestingT, method any, arguments ...any) bool {
 	methodName, ins := mm.getMethodNameAndNumArgs(method)
+	arguments, captors := replaceCaptors(padArguments(arguments, ins))
+	if matched := mm.matchingCalls(methodName, arguments); len(matched) > 0 {
+		captureRecords(captors, matched...)
+		mm.verify(matched...)
+		return true
+	}
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf("%s", mm.notCalledReport(methodName, arguments))
+	return false
+}
+
+func padArguments(arguments []any, ins int) []any {
 	for i := ins - len(arguments); i > 0; i-- {
 		arguments = append(arguments, mock.Anything)
 	}
-	return mm.Mock.AssertCalled(t, methodName, arguments...)
+	return arguments
+}
+
+// matchingCalls returns the records of calls to a method that match the args
+func (mm *MockMethods) matchingCalls(methodName string, arguments mock.Arguments) []CallRecord {
+	result := make([]CallRecord, 0)
+	for _, record := range mm.callsOf(methodName) {
+		if _, differences := arguments.Diff(record.Arguments); differences == 0 {
+			result = append(result, record)
+		}
+	}
+	return result
 }
 
 // AssertMethodNotCalled is the same as Mock.AssertNotCalled() (https://pkg.go.dev/github.com/stretchr/testify/mock#Mock.AssertNotCalled)
@@ -201,15 +550,21 @@ func (mm *MockMethods) AssertMethodCalled(t *testing.T, method any, arguments ..
 // # Except the method can be specified by func pointer or name
 //
 // Also, if the number of arguments specified is less than the expected args of the method then
-// the arguments is padded with mock.Anything
+// the arguments is padded with mock.Anything (and the calls are matched from the mock's call records - see CallsOf)
 //
 //go:noinline
-func (mm *MockMethods) AssertMethodNotCalled(t *testing.T, method any, arguments ...any) bool {
+func (mm *MockMethods) AssertMethodNotCalled(t mock.TestingT, method any, arguments ...any) bool {
 	methodName, ins := mm.getMethodNameAndNumArgs(method)
-	for i := ins - len(arguments); i > 0; i-- {
-		arguments = append(arguments, mock.Anything)
+	arguments, _ = replaceCaptors(padArguments(arguments, ins))
+	matched := mm.matchingCalls(methodName, arguments)
+	if len(matched) == 0 {
+		return true
 	}
-	return mm.Mock.AssertNotCalled(t, methodName, arguments...)
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	t.Errorf("mmock: expected %s not to be called but it was called %d times", callString(methodName, arguments), len(matched))
+	return false
 }
 
 func methodInsAndOuts(method reflect.Method, errs bool) (ins []any, outs []any) {
@@ -249,27 +604,72 @@ func excludeMethods() map[string]bool {
 }
 
 func (mm *MockMethods) getMethodNameAndNumArgs(method any) (string, int) {
+	methodName, mt := mm.getMethod(method)
+	return methodName, numArgs(mt)
+}
+
+// getMethod resolves the method name and func type (without receiver) of a method specified by func or name
+//
+// The returned func type is nil if the method is specified by name and the mock type is not known
+func (mm *MockMethods) getMethod(method any) (string, reflect.Type) {
 	to := reflect.TypeOf(method)
 	if to.Kind() == reflect.String {
 		methodName := method.(string)
 		if mm.mockOf == nil {
-			return methodName, -1
+			return methodName, nil
 		}
 		if m, ok := reflect.TypeOf(mm.mockOf).MethodByName(methodName); ok {
-			return methodName, m.Type.NumIn() - 1
+			return methodName, methodFuncType(m)
 		}
-		panic(fmt.Sprintf("method '%s' does not exist", methodName))
+		mm.fail("method '%s' does not exist", methodName)
+		return methodName, nil
 	} else if to.Kind() != reflect.Func {
-		panic("not a method")
+		mm.fail("not a method")
+		return "", nil
 	}
 
-	fn := parseMethodName(runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name())
+	fullName := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
+	fn := parseMethodName(fullName)
 	if mm.mockOf != nil {
 		if _, ok := reflect.TypeOf(mm.mockOf).MethodByName(fn); !ok {
-			panic(fmt.Sprintf("method '%s' does not exist", fn))
+			mm.fail("method '%s' does not exist", fn)
 		}
 	}
-	return fn, to.NumIn()
+	if isMethodExpression(fullName, fn, to) {
+		// method expression (e.g. (*MyMock).SomeMethod or MyInterface.SomeMethod) - the first arg is the receiver...
+		if mm.mockOf != nil && !reflect.TypeOf(mm.mockOf).AssignableTo(to.In(0)) {
+			mm.fail("mmock: method expression %s() is for type %s - not this mock (%T)", fn, to.In(0).String(), mm.mockOf)
+		}
+		return fn, funcTypeWithoutReceiver(to)
+	} else if !mm.isReceiverOf(method) {
+		mm.fail("mmock: method value %s() belongs to a different mock instance - not this mock (%T)", fn, mm.mockOf)
+	}
+	return fn, to
+}
+
+// methodFuncType returns the func type of a method without the receiver arg
+func methodFuncType(m reflect.Method) reflect.Type {
+	return funcTypeWithoutReceiver(m.Type)
+}
+
+// funcTypeWithoutReceiver returns the func type without the first (receiver) arg
+func funcTypeWithoutReceiver(ft reflect.Type) reflect.Type {
+	ins := make([]reflect.Type, ft.NumIn()-1)
+	for i := range ins {
+		ins[i] = ft.In(i + 1)
+	}
+	outs := make([]reflect.Type, ft.NumOut())
+	for i := range outs {
+		outs[i] = ft.Out(i)
+	}
+	return reflect.FuncOf(ins, outs, ft.IsVariadic())
+}
+
+func numArgs(mt reflect.Type) int {
+	if mt == nil {
+		return -1
+	}
+	return mt.NumIn()
 }
 
 var gccRegex = regexp.MustCompile("\\.pN\\d+_")
@@ -1,6 +1,7 @@
 package mmock
 
 import (
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
@@ -159,6 +160,154 @@ func TestNewMockOf__PanicsWithBadMockImpl(t *testing.T) {
 	})
 }
 
+func TestMockMethods_SetNice(t *testing.T) {
+	m := NewMockT[mockedMy, my](t)
+	m.SetNice(true)
+	m.OnMethod(m.DoSomething, "a").Return(&SomeStruct{SomeValue: "a"}, nil)
+
+	r, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+	r, err = m.DoSomething("b", 2)
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+	r2, err := m.DoSomethingElse("c", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, SomeStruct{}, r2)
+
+	m.AssertNumberOfMethodCalls(t, m.DoSomething, 2)
+	m.AssertMethodCalled(t, m.DoSomething, "b", 2)
+	m.AssertMethodCalled(t, m.DoSomethingElse, "c", 3)
+	assert.True(t, m.AssertExpectations(t))
+}
+
+func TestMockMethods_SetNice_Off(t *testing.T) {
+	m := NewMock[mockedMy]()
+	m.SetNice(true)
+	_, _ = m.DoSomething("a", 1)
+	m.SetNice(false)
+	assert.Panics(t, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+}
+
+func TestMockMethods_SetNice_FailsWithoutMockOf(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := new(mockedMy)
+	m.Test(ft)
+	m.SetNice(true)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	assert.Contains(t, ft.errors[0], "nice mock cannot determine the results of method 'DoSomething'")
+}
+
+func TestNewMockT(t *testing.T) {
+	m := NewMockT[mockedMy, my](t)
+	m.OnMethod(m.DoSomething).Return(&SomeStruct{SomeValue: "a"}, nil)
+	r, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+	// expectations are asserted on cleanup
+}
+
+func TestNewMockT_FailsUnmetExpectations(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	m.OnMethod(m.DoSomething).Return(nil, nil)
+	ft.cleanup()
+	assert.True(t, ft.failed)
+}
+
+func TestNewMockT_FailsUnexpectedCall(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	assert.True(t, ft.failed)
+	assert.Contains(t, ft.errors[0], "mmock: unexpected call: DoSomething(\"a\", 1)")
+	assert.Contains(t, ft.errors[0], "there are no expectations of DoSomething()")
+}
+
+func TestNewMockT_FailsUnknownMethod(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		m.OnMethod("Unknown")
+	})
+	assert.Equal(t, []string{"method 'Unknown' does not exist"}, ft.errors)
+}
+
+func TestNewMockT_FailsWithBadMockImpl(t *testing.T) {
+	type otherMockedImpl struct {
+		MockMethods
+	}
+	ft := &fakeTB{TB: t}
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = NewMockT[otherMockedImpl, my](ft)
+	})
+	assert.Contains(t, ft.errors[0], "does not implement interface 'my'")
+}
+
+func BenchmarkNewMockT(b *testing.B) {
+	m := NewMockT[mockedMy, my](b)
+	m.OnMethod(m.DoSomethingElse).Return(SomeStruct{}, nil)
+	for i := 0; i < b.N; i++ {
+		_, _ = m.DoSomethingElse("a", i)
+	}
+	m.AssertMethodCalled(b, m.DoSomethingElse)
+	m.AssertNumberOfMethodCalls(b, m.DoSomethingElse, b.N)
+	m.AssertMethodNotCalled(b, m.DoSomething)
+}
+
+const failNowSentinel = "FailNow called"
+
+// fakeTB records failures (and cleanups) rather than failing the real test
+type fakeTB struct {
+	testing.TB
+	errors   []string
+	failed   bool
+	cleanups []func()
+	logs     []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Failed() bool {
+	return f.failed
+}
+
+func (f *fakeTB) Logf(format string, args ...any) {
+	f.logs = append(f.logs, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.failed = true
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Fatal(args ...any) {
+	f.failed = true
+	f.errors = append(f.errors, fmt.Sprint(args...))
+	f.FailNow()
+}
+
+func (f *fakeTB) FailNow() {
+	f.failed = true
+	panic(failNowSentinel)
+}
+
+func (f *fakeTB) Cleanup(fn func()) {
+	f.cleanups = append(f.cleanups, fn)
+}
+
+func (f *fakeTB) cleanup() {
+	for i := len(f.cleanups) - 1; i >= 0; i-- {
+		f.cleanups[i]()
+	}
+}
+
 func TestParseMethodName(t *testing.T) {
 	mn := parseMethodName("github.com/go-andiamo/mmock.(*mockedMy).DoSomething-fm")
 	assert.Equal(t, "DoSomething", mn)
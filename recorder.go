@@ -0,0 +1,199 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Recorder records the calls across several mocks - so that the order of calls on different mocks can be asserted
+//
+// Example:
+//
+//	rec := mmock.NewRecorder(cache, db, pub)
+//	...
+//	rec.AssertCallSequence(t, cache.Get, db.Query, pub.Publish)
+type Recorder struct {
+	mocks []recordedMock
+}
+
+type recordedMock struct {
+	mocked any
+	mm     *MockMethods
+	name   string
+}
+
+// NewRecorder creates a new Recorder of the specified mocks (each must embed MockMethods)
+//
+// Note: all calls on the mocks (including calls made before the recorder was created) are seen by the recorder - every
+// call records a sequence number that is increasing across all calls of all mocks
+func NewRecorder(mocks ...any) *Recorder {
+	r := &Recorder{}
+	for _, mocked := range mocks {
+		mmp, ok := mocked.(mockMethodsProvider)
+		if !ok {
+			panic(fmt.Sprintf("type '%s' is not MockMethods (add field mmock.MockMethods)", reflect.TypeOf(mocked).String()))
+		}
+		r.mocks = append(r.mocks, recordedMock{
+			mocked: mocked,
+			mm:     mmp.getMockMethods(),
+			name:   reflect.TypeOf(mocked).String(),
+		})
+	}
+	return r
+}
+
+// Calls returns the records of all calls made on all the recorded mocks (in the order they were made)
+func (r *Recorder) Calls() []CallRecord {
+	calls := r.calls()
+	result := make([]CallRecord, len(calls))
+	for i, c := range calls {
+		result[i] = c.record
+	}
+	return result
+}
+
+type recordedCall struct {
+	mock   int // index of the mock in the recorder
+	record CallRecord
+}
+
+func (r *Recorder) calls() []recordedCall {
+	result := make([]recordedCall, 0)
+	for i, rm := range r.mocks {
+		rm.mm.lock.Lock()
+		for _, record := range rm.mm.records {
+			result = append(result, recordedCall{mock: i, record: *record})
+		}
+		rm.mm.lock.Unlock()
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].record.Sequence < result[j].record.Sequence
+	})
+	return result
+}
+
+// AssertCalledBefore asserts that the first call of a method was made before the first call of another method
+//
+// The methods are specified by method value (e.g. cache.Get) - and can be on different mocks
+func (r *Recorder) AssertCalledBefore(t mock.TestingT, before any, after any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	bm, err := r.resolve(before)
+	if err != "" {
+		t.Errorf("%s", err)
+		return false
+	}
+	am, err := r.resolve(after)
+	if err != "" {
+		t.Errorf("%s", err)
+		return false
+	}
+	bCalls, aCalls := bm.rm.mm.callsOf(bm.method), am.rm.mm.callsOf(am.method)
+	if len(bCalls) == 0 || len(aCalls) == 0 || bCalls[0].Sequence > aCalls[0].Sequence {
+		t.Errorf("mmock: expected %s to be called before %s\n\tactual calls:%s", bm.String(), am.String(), r.describeCalls(bm, am))
+		return false
+	}
+	return true
+}
+
+// AssertCallSequence asserts that methods were called in the specified sequence
+//
+// The methods are specified by method value (e.g. cache.Get) - and can be on different mocks
+//
+// The sequence does not need to be contiguous - other calls (including other calls of the methods) may be made in between
+func (r *Recorder) AssertCallSequence(t mock.TestingT, methods ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	rms := make([]resolvedMethod, len(methods))
+	for i, method := range methods {
+		rm, err := r.resolve(method)
+		if err != "" {
+			t.Errorf("%s", err)
+			return false
+		}
+		rms[i] = rm
+	}
+	next := 0
+	for _, c := range r.calls() {
+		if next < len(rms) && rms[next].matches(c) {
+			next++
+		}
+	}
+	if next == len(rms) {
+		return true
+	}
+	names := make([]string, len(rms))
+	for i, rm := range rms {
+		names[i] = rm.String()
+	}
+	t.Errorf("mmock: expected call sequence:\n\t\t%s\n\tbut %s was not called (in sequence)\n\tactual calls:%s",
+		strings.Join(names, "\n\t\t"), names[next], r.describeCalls(rms...))
+	return false
+}
+
+type resolvedMethod struct {
+	mock   int // index of the mock in the recorder
+	rm     recordedMock
+	method string
+}
+
+func (rm resolvedMethod) matches(c recordedCall) bool {
+	return c.mock == rm.mock && c.record.Method == rm.method
+}
+
+func (rm resolvedMethod) String() string {
+	return fmt.Sprintf("(%s).%s()", rm.rm.name, rm.method)
+}
+
+// resolve resolves a method value to the recorded mock that owns it
+func (r *Recorder) resolve(method any) (resolvedMethod, string) {
+	fv := reflect.ValueOf(method)
+	if fv.Kind() != reflect.Func {
+		return resolvedMethod{}, "mmock: recorder methods must be specified by method value (e.g. myMock.SomeMethod)"
+	}
+	fullName := runtime.FuncForPC(fv.Pointer()).Name()
+	methodName := parseMethodName(fullName)
+	candidates := make([]int, 0, 1)
+	for i, rm := range r.mocks {
+		if _, ok := reflect.TypeOf(rm.mocked).MethodByName(methodName); !ok {
+			continue
+		}
+		if receiver, ok := methodValueReceiver(method, reflect.TypeOf(rm.mocked)); ok {
+			if receiver == reflect.ValueOf(rm.mocked).UnsafePointer() {
+				candidates = append(candidates, i)
+			}
+		} else {
+			candidates = append(candidates, i)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return resolvedMethod{}, fmt.Sprintf("mmock: method '%s' does not belong to any mock of the recorder", fullName)
+	case 1:
+		return resolvedMethod{mock: candidates[0], rm: r.mocks[candidates[0]], method: methodName}, ""
+	}
+	return resolvedMethod{}, fmt.Sprintf("mmock: method '%s' is ambiguous - more than one mock of the recorder has the method", fullName)
+}
+
+// describeCalls describes the calls of the specified methods (in the order they were made)
+func (r *Recorder) describeCalls(rms ...resolvedMethod) string {
+	var sb strings.Builder
+	for _, c := range r.calls() {
+		for _, rm := range rms {
+			if rm.matches(c) {
+				sb.WriteString(fmt.Sprintf("\n\t\t[%d] (%s).%s", c.record.Sequence, rm.rm.name, callString(c.record.Method, c.record.Arguments)))
+				break
+			}
+		}
+	}
+	if sb.Len() == 0 {
+		return " none"
+	}
+	return sb.String()
+}
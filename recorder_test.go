@@ -0,0 +1,77 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	cache := NewMock[diagnosticMock]()
+	db := NewMock[protocolMock]()
+	pub := NewMock[captorMock]()
+	cache.OnMethod(cache.Delete).Return(nil)
+	db.OnMethod(db.Write)
+	pub.OnMethod(pub.Print)
+	rec := NewRecorder(cache, db, pub)
+
+	_ = cache.Delete("a")
+	db.Write("b")
+	pub.Print(nil)
+	_ = cache.Delete("c")
+
+	calls := rec.Calls()
+	require.Equal(t, 4, len(calls))
+	assert.Equal(t, "Delete", calls[0].Method)
+	assert.Equal(t, "Write", calls[1].Method)
+	assert.Equal(t, "Print", calls[2].Method)
+	assert.Equal(t, "Delete", calls[3].Method)
+
+	assert.True(t, rec.AssertCalledBefore(t, cache.Delete, db.Write))
+	assert.True(t, rec.AssertCallSequence(t, cache.Delete, db.Write, pub.Print))
+	assert.True(t, rec.AssertCallSequence(t, db.Write, cache.Delete))
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, rec.AssertCalledBefore(ft, pub.Print, db.Write))
+	assert.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "mmock: expected (*mmock.captorMock).Print() to be called before (*mmock.protocolMock).Write()\n\tactual calls:\n\t\t[")
+	assert.Contains(t, ft.errors[0], "] (*mmock.protocolMock).Write(\"b\")\n\t\t[")
+	assert.Contains(t, ft.errors[0], "] (*mmock.captorMock).Print(nil)")
+
+	ft = &fakeTB{TB: t}
+	assert.False(t, rec.AssertCallSequence(ft, db.Write, pub.Print, db.Write))
+	assert.Contains(t, ft.errors[0], "mmock: expected call sequence:\n\t\t(*mmock.protocolMock).Write()\n\t\t(*mmock.captorMock).Print()\n\t\t(*mmock.protocolMock).Write()\n\tbut (*mmock.protocolMock).Write() was not called (in sequence)")
+
+	ft = &fakeTB{TB: t}
+	assert.False(t, rec.AssertCalledBefore(ft, db.Commit, pub.Print))
+	assert.Contains(t, ft.errors[0], "mmock: expected (*mmock.protocolMock).Commit() to be called before (*mmock.captorMock).Print()")
+}
+
+func TestRecorder_ResolvesMockInstances(t *testing.T) {
+	first := NewMock[diagnosticMock]()
+	second := NewMock[diagnosticMock]()
+	other := NewMock[diagnosticMock]()
+	first.OnMethod(first.Delete).Return(nil)
+	second.OnMethod(second.Delete).Return(nil)
+	rec := NewRecorder(first, second)
+
+	_ = second.Delete("a")
+	_ = first.Delete("b")
+	assert.True(t, rec.AssertCalledBefore(t, second.Delete, first.Delete))
+
+	ft := &fakeTB{TB: t}
+	assert.False(t, rec.AssertCalledBefore(ft, first.Delete, second.Delete))
+	assert.False(t, rec.AssertCalledBefore(ft, other.Delete, second.Delete))
+	assert.False(t, rec.AssertCalledBefore(ft, "Delete", second.Delete))
+	assert.False(t, rec.AssertCallSequence(ft, (*diagnosticMock).Delete))
+	require.Equal(t, 4, len(ft.errors))
+	assert.Equal(t, "mmock: method 'github.com/go-andiamo/mmock.(*diagnosticMock).Delete-fm' does not belong to any mock of the recorder", ft.errors[1])
+	assert.Equal(t, "mmock: recorder methods must be specified by method value (e.g. myMock.SomeMethod)", ft.errors[2])
+	assert.Equal(t, "mmock: method 'github.com/go-andiamo/mmock.(*diagnosticMock).Delete' is ambiguous - more than one mock of the recorder has the method", ft.errors[3])
+}
+
+func TestNewRecorder_PanicsWithNonMock(t *testing.T) {
+	assert.PanicsWithValue(t, "type '*mmock.underlyingFull' is not MockMethods (add field mmock.MockMethods)", func() {
+		NewRecorder(&underlyingFull{})
+	})
+}
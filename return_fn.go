@@ -0,0 +1,83 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/mock"
+	"reflect"
+)
+
+// ReturnFn sets a func that computes the return values of the call from the actual args, e.g.
+//
+//	myMock.OnMethod(myMock.Lookup).ReturnFn(func(id string) (*Item, error) {
+//		return &Item{ID: id}, nil
+//	})
+//
+// The func must have exactly the same signature as the mocked method (and fails if it does not) - it is called with the
+// actual args of each call and its results are returned by the call
+//
+// Note: for variadic methods, the func is called with the variadic args (whether the mock passed them individually or as a slice)
+func (c *Call) ReturnFn(fn any) *Call {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func {
+		c.mm.fail("mmock: %s() ReturnFn expected a func but got %T", c.Method, fn)
+		return c
+	} else if c.method != nil && fv.Type() != c.method {
+		c.mm.fail("mmock: %s() ReturnFn expected func of type %s but got %s", c.Method, c.method.String(), fv.Type().String())
+		return c
+	}
+	c.setReturnFn(func(args mock.Arguments) mock.Arguments {
+		return c.callReturnFn(fv, args)
+	})
+	return c
+}
+
+// callReturnFn calls a ReturnFn func with the actual args of a call - returning its results
+func (c *Call) callReturnFn(fv reflect.Value, args mock.Arguments) mock.Arguments {
+	ft := fv.Type()
+	fixed := ft.NumIn()
+	if ft.IsVariadic() {
+		fixed--
+	}
+	if len(args) < fixed || (!ft.IsVariadic() && len(args) != fixed) {
+		c.mm.fail("mmock: %s() ReturnFn expected %d args but got %d", c.Method, ft.NumIn(), len(args))
+		return nil
+	}
+	in := make([]reflect.Value, 0, len(args))
+	for i := 0; i < fixed; i++ {
+		in = append(in, c.returnFnArg(i, args[i], ft.In(i)))
+	}
+	var results []reflect.Value
+	if !ft.IsVariadic() {
+		results = fv.Call(in)
+	} else if st := ft.In(fixed); len(args) == fixed+1 && args[fixed] != nil && reflect.TypeOf(args[fixed]).AssignableTo(st) {
+		// variadic args were passed as a slice...
+		results = fv.CallSlice(append(in, reflect.ValueOf(args[fixed]).Convert(st)))
+	} else {
+		for i := fixed; i < len(args); i++ {
+			in = append(in, c.returnFnArg(i, args[i], st.Elem()))
+		}
+		results = fv.Call(in)
+	}
+	returns := make(mock.Arguments, len(results))
+	for i, r := range results {
+		returns[i] = r.Interface()
+	}
+	return returns
+}
+
+func (c *Call) returnFnArg(i int, arg any, t reflect.Type) reflect.Value {
+	v, ok := argValue(arg, t)
+	if !ok {
+		c.mm.fail("mmock: %s() ReturnFn arg [%d]: expected type %s but got %T", c.Method, i, t.String(), arg)
+	}
+	return v
+}
+
+// argValue converts an arg to the specified type (nil is converted to the zero value)
+func argValue(arg any, t reflect.Type) (reflect.Value, bool) {
+	if arg == nil {
+		return reflect.Zero(t), true
+	} else if v, ok := convertValue(reflect.ValueOf(arg), t); ok {
+		return v, true
+	}
+	return reflect.Zero(t), false
+}
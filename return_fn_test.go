@@ -0,0 +1,108 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestCall_ReturnFn(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).ReturnFn(func(s string, i int) (*SomeStruct, error) {
+		if i < 0 {
+			return nil, errors.New("negative")
+		}
+		return &SomeStruct{SomeValue: strings.Repeat(s, i)}, nil
+	})
+
+	r, err := m.DoSomething("a", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "aaa", r.SomeValue)
+	r, err = m.DoSomething("b", -1)
+	assert.EqualError(t, err, "negative")
+	assert.Nil(t, r)
+	assert.Equal(t, &SomeStruct{SomeValue: "aaa"}, m.NthCall(m.DoSomething, 1).Returns[0])
+}
+
+func TestCall_ReturnFn_Validates(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnFn expected func of type func(string, int) (*mmock.SomeStruct, error) but got func(string) (*mmock.SomeStruct, error)", func() {
+		m.OnMethod(m.DoSomething).ReturnFn(func(s string) (*SomeStruct, error) {
+			return nil, nil
+		})
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnFn expected a func but got string", func() {
+		m.OnMethod(m.DoSomething).ReturnFn("foo")
+	})
+}
+
+func TestCall_ReturnFn_UnknownMethodType(t *testing.T) {
+	m := &mockedMy{}
+	m.OnMethod("DoSomething", mock.Anything, mock.Anything).ReturnFn(func(s string, i int64) (*SomeStruct, error) {
+		return &SomeStruct{SomeValue: s}, nil
+	})
+	r, err := m.DoSomething("a", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+
+	m = &mockedMy{}
+	m.OnMethod("DoSomething", mock.Anything, mock.Anything).ReturnFn(func(s string, b bool) (*SomeStruct, error) {
+		return nil, nil
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnFn arg [1]: expected type bool but got int", func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+
+	m = &mockedMy{}
+	m.OnMethod("DoSomething", mock.Anything, mock.Anything).ReturnFn(func(s string) (*SomeStruct, error) {
+		return nil, nil
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnFn expected 1 args but got 2", func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+}
+
+func TestCall_ReturnFn_Variadic(t *testing.T) {
+	m := NewMock[variadicMock]()
+	m.OnMethod(m.Sum, mock.Anything, mock.Anything, mock.Anything).ReturnFn(func(s string, nums ...int) int {
+		total := 0
+		for _, n := range nums {
+			total += n
+		}
+		return total
+	})
+	assert.Equal(t, 3, m.Sum("a", 1, 2))
+
+	sm := NewMock[sliceVariadicMock]()
+	sm.OnMethod(sm.Join).ReturnFn(func(sep string, parts ...string) string {
+		return strings.Join(parts, sep)
+	})
+	assert.Equal(t, "a,b,c", sm.Join(",", "a", "b", "c"))
+	assert.Equal(t, "", sm.Join(","))
+}
+
+func TestCall_ReturnFn_ReplacesSequence(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).FailTimes(1, errTimeout).ReturnFn(func(s string, i int) (*SomeStruct, error) {
+		return &SomeStruct{}, nil
+	})
+	_, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+
+	m.OnMethod(m.DoSomethingElse).ReturnFn(func(s string, i int) (SomeStruct, error) {
+		return SomeStruct{}, nil
+	}).FailTimes(1, errTimeout)
+	_, err = m.DoSomethingElse("a", 1)
+	assert.Equal(t, errTimeout, err)
+}
+
+type sliceVariadicMock struct {
+	MockMethods
+}
+
+func (m *sliceVariadicMock) Join(sep string, parts ...string) string {
+	return As1[string](m.Called(sep, parts))
+}
@@ -0,0 +1,138 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+)
+
+// Returns is the return values of one call in a return sequence (see Ret and Call.ReturnSequence)
+type Returns []any
+
+// Ret is the return values of one call in a return sequence, e.g.
+//
+//	myMock.OnMethod(myMock.Fetch).ReturnSequence(mmock.Ret(nil, errTimeout), mmock.Ret(data, nil))
+func Ret(values ...any) Returns {
+	return values
+}
+
+// Exhausted is what a return sequence does once all of its return values have been used (see Call.WhenExhausted)
+type Exhausted int
+
+const (
+	// RepeatLast repeats the last return values of the sequence (the default)
+	RepeatLast Exhausted = iota
+	// FailWhenExhausted fails any further call
+	FailWhenExhausted
+	// Cycle starts the sequence again from the first return values
+	Cycle
+)
+
+type returnSequence struct {
+	returns   []Returns
+	next      int
+	exhausted Exhausted
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ReturnSequence sets the return values of successive calls - the first call returns the first Ret, the second call the second Ret, etc.
+//
+// Once all the return values have been used, the last Ret is repeated (use WhenExhausted to fail or cycle instead)
+//
+// Each Ret is checked against the mocked method's result types (in the same way as Return)
+func (c *Call) ReturnSequence(returns ...Returns) *Call {
+	if len(returns) == 0 {
+		c.mm.fail("mmock: %s() ReturnSequence requires at least one Ret", c.Method)
+		return c
+	}
+	for i, r := range returns {
+		c.validateReturns(fmt.Sprintf("ReturnSequence [%d]", i), r)
+	}
+	c.setSequence(&returnSequence{returns: returns})
+	return c
+}
+
+// ThenReturn adds return values to the end of the call's return sequence (see ReturnSequence and FailTimes), e.g.
+//
+//	myMock.OnMethod(myMock.Fetch).FailTimes(2, errTimeout).ThenReturn(data, nil)
+func (c *Call) ThenReturn(returnArguments ...any) *Call {
+	c.validateReturns("ThenReturn", returnArguments)
+	c.appendSequence(returnArguments)
+	return c
+}
+
+// FailTimes adds n return values to the call's return sequence that return the specified error
+//
+// The error is returned in the last error result of the mocked method - all other results are returned as zero values
+func (c *Call) FailTimes(n int, err error) *Call {
+	if c.method == nil {
+		c.mm.fail("mmock: %s() FailTimes cannot determine the error result (mock type not known)", c.Method)
+		return c
+	}
+	errIndex := errorResultIndex(c.method)
+	if errIndex == -1 {
+		c.mm.fail("mmock: %s() FailTimes method has no error result", c.Method)
+		return c
+	}
+	for ; n > 0; n-- {
+		r := make(Returns, c.method.NumOut())
+		r[errIndex] = err
+		c.appendSequence(r)
+	}
+	return c
+}
+
+// WhenExhausted sets what the call's return sequence does once all of its return values have been used
+func (c *Call) WhenExhausted(exhausted Exhausted) *Call {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	if c.sequence == nil {
+		c.sequence = &returnSequence{}
+		c.returnFn = c.nextReturns
+	}
+	c.sequence.exhausted = exhausted
+	return c
+}
+
+func (c *Call) setSequence(seq *returnSequence) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.sequence = seq
+	c.returnFn = c.nextReturns
+}
+
+func (c *Call) appendSequence(returns Returns) {
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	if c.sequence == nil {
+		c.sequence = &returnSequence{}
+		c.returnFn = c.nextReturns
+	}
+	c.sequence.returns = append(c.sequence.returns, returns)
+}
+
+// nextReturns is the returnFn of a call with a return sequence
+func (c *Call) nextReturns(_ mock.Arguments) mock.Arguments {
+	c.mm.lock.Lock()
+	seq := c.sequence
+	l, i := len(seq.returns), seq.next
+	seq.next++
+	var result Returns
+	ok := true
+	switch {
+	case i < l:
+		result = seq.returns[i]
+	case l > 0 && seq.exhausted == RepeatLast:
+		result = seq.returns[l-1]
+	case l > 0 && seq.exhausted == Cycle:
+		result = seq.returns[i%l]
+	default:
+		ok = false
+	}
+	c.mm.lock.Unlock()
+	if !ok {
+		c.mm.fail("mmock: %s() return sequence exhausted - call %d but the sequence only has %d return values", c.Method, i+1, l)
+	}
+	return mock.Arguments(result)
+}
@@ -0,0 +1,128 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var errTimeout = errors.New("timeout")
+
+func TestCall_ReturnSequence(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).ReturnSequence(Ret(nil, errTimeout), Ret(nil, errTimeout), Ret(&SomeStruct{SomeValue: "a"}, nil))
+
+	_, err := m.DoSomething("a", 1)
+	assert.Equal(t, errTimeout, err)
+	_, err = m.DoSomething("a", 1)
+	assert.Equal(t, errTimeout, err)
+	r, err := m.DoSomething("a", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+	// repeats last by default...
+	r, err = m.DoSomething("a", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+
+	calls := m.CallsOf(m.DoSomething)
+	require.Equal(t, 4, len(calls))
+	assert.Equal(t, errTimeout, calls[0].Returns[1])
+	assert.Equal(t, &SomeStruct{SomeValue: "a"}, calls[2].Returns[0])
+}
+
+func TestCall_ReturnSequence_Cycle(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).ReturnSequence(Ret(nil, errTimeout), Ret(&SomeStruct{}, nil)).WhenExhausted(Cycle)
+
+	for i := 0; i < 4; i++ {
+		_, err := m.DoSomething("a", 1)
+		if i%2 == 0 {
+			assert.Equal(t, errTimeout, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestCall_ReturnSequence_FailWhenExhausted(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).WhenExhausted(FailWhenExhausted).ReturnSequence(Ret(&SomeStruct{}, nil))
+
+	_, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	// ReturnSequence replaced the sequence (so it repeats last)...
+	_, err = m.DoSomething("a", 1)
+	assert.NoError(t, err)
+
+	m = NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).ReturnSequence(Ret(&SomeStruct{}, nil)).WhenExhausted(FailWhenExhausted)
+	_, err = m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	assert.PanicsWithValue(t, "mmock: DoSomething() return sequence exhausted - call 2 but the sequence only has 1 return values", func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	assert.Equal(t, 2, len(m.CallsOf(m.DoSomething)))
+}
+
+func TestCall_ReturnSequence_FailWhenExhausted_Bound(t *testing.T) {
+	ft := &fakeTB{}
+	m := NewMockOf[mockedMy, my]()
+	m.Test(ft)
+	m.OnMethod(m.DoSomething).ReturnSequence(Ret(&SomeStruct{}, nil)).WhenExhausted(FailWhenExhausted)
+	_, _ = m.DoSomething("a", 1)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	require.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "return sequence exhausted")
+}
+
+func TestCall_ReturnSequence_Validates(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnSequence [1] value [1]: expected type error but got string", func() {
+		m.OnMethod(m.DoSomething).ReturnSequence(Ret(nil, nil), Ret(nil, "foo"))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnSequence [0] expected 2 values but got 1", func() {
+		m.OnMethod(m.DoSomething).ReturnSequence(Ret(nil))
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ReturnSequence requires at least one Ret", func() {
+		m.OnMethod(m.DoSomething).ReturnSequence()
+	})
+	assert.PanicsWithValue(t, "mmock: DoSomething() ThenReturn value [0]: expected type *mmock.SomeStruct but got string", func() {
+		m.OnMethod(m.DoSomething).ThenReturn("foo", nil)
+	})
+}
+
+func TestCall_FailTimes_ThenReturn(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).FailTimes(2, errTimeout).ThenReturn(&SomeStruct{SomeValue: "a"}, nil)
+
+	for i := 0; i < 2; i++ {
+		r, err := m.DoSomething("a", 1)
+		assert.Equal(t, errTimeout, err)
+		assert.Nil(t, r)
+	}
+	r, err := m.DoSomething("a", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+}
+
+func TestCall_FailTimes_Errors(t *testing.T) {
+	m := &mockedMy{}
+	assert.PanicsWithValue(t, "mmock: DoSomething() FailTimes cannot determine the error result (mock type not known)", func() {
+		m.OnMethod("DoSomething").FailTimes(1, errTimeout)
+	})
+	cm := NewMock[captorMock]()
+	assert.PanicsWithValue(t, "mmock: Print() FailTimes method has no error result", func() {
+		cm.OnMethod(cm.Print).FailTimes(1, errTimeout)
+	})
+}
+
+func TestCall_Return_ReplacesSequence(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).FailTimes(1, errTimeout).Return(&SomeStruct{}, nil)
+
+	_, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+)
+
+// argSetter is a value to be set through a (pointer, slice or map) arg of a call (see Call.SetArg)
+type argSetter struct {
+	index int
+	value any
+}
+
+// SetArg sets a value through the arg at the specified index when the call is made - e.g. for methods that return
+// results by writing to a pointer arg (such as Decode(v any) error), e.g.
+//
+//	myMock.OnMethod(myMock.Decode).SetArg(0, Thing{Name: "foo"}).Return(nil)
+//
+// The arg must be a non-nil pointer (the value is assigned to what it points to), a slice (the elements of the value, which must
+// be a slice or array, are copied to it) or a map (the entries of the value, which must be a map, are put into it) - and the
+// call fails if the arg is not settable or the value cannot be assigned
+//
+// For variadic methods, the index is the position of the arg in the call (whether the mock passed the variadic args individually or as a slice)
+func (c *Call) SetArg(index int, value any) *Call {
+	c.validateSetArg(index)
+	c.mm.lock.Lock()
+	defer c.mm.lock.Unlock()
+	c.setters = append(c.setters, argSetter{index: index, value: value})
+	return c
+}
+
+// SetArgs sets values through the args of the call (the first value through arg [0], the second through arg [1], etc.) - see SetArg
+//
+// For variadic methods (such as Scan(dest ...any) error) the values are set through the variadic args, e.g.
+//
+//	myMock.OnMethod(myMock.Scan).SetArgs("foo", 42).Return(nil)
+func (c *Call) SetArgs(values ...any) *Call {
+	for i, v := range values {
+		c.SetArg(i, v)
+	}
+	return c
+}
+
+// validateSetArg checks the SetArg index against the mocked method (and fails if the arg can never be set through)
+func (c *Call) validateSetArg(index int) {
+	if index < 0 {
+		c.mm.fail("mmock: %s() SetArg [%d]: invalid arg index", c.Method, index)
+		return
+	} else if c.method == nil {
+		return
+	}
+	ins := c.method.NumIn()
+	var at reflect.Type
+	if c.method.IsVariadic() && index >= ins-1 {
+		at = c.method.In(ins - 1).Elem()
+	} else if index < ins {
+		at = c.method.In(index)
+	} else {
+		c.mm.fail("mmock: %s() SetArg [%d]: method only has %d args", c.Method, index, ins)
+		return
+	}
+	switch at.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return
+	}
+	c.mm.fail("mmock: %s() SetArg [%d]: arg of type %s cannot be set through (must be a pointer, slice or map)", c.Method, index, at.String())
+}
+
+// applyArgSetters sets the values through the args of a call (see SetArg)
+func (c *Call) applyArgSetters(setters []argSetter, args mock.Arguments) {
+	args = c.expandVariadic(args)
+	for _, s := range setters {
+		if s.index >= len(args) {
+			c.mm.fail("mmock: %s() SetArg [%d]: call only has %d args", c.Method, s.index, len(args))
+		} else if msg := setThrough(args[s.index], s.value); msg != "" {
+			c.mm.fail("mmock: %s() SetArg [%d]: %s", c.Method, s.index, msg)
+		}
+	}
+}
+
+// expandVariadic expands the variadic args of a call where they were passed as a slice
+func (c *Call) expandVariadic(args mock.Arguments) mock.Arguments {
+	if c.method == nil || !c.method.IsVariadic() || len(args) != c.method.NumIn() {
+		return args
+	}
+	last := len(args) - 1
+	sv := reflect.ValueOf(args[last])
+	if !sv.IsValid() || sv.Type() != c.method.In(last) {
+		return args
+	}
+	result := append(make(mock.Arguments, 0, last+sv.Len()), args[:last]...)
+	for i := 0; i < sv.Len(); i++ {
+		result = append(result, sv.Index(i).Interface())
+	}
+	return result
+}
+
+// setThrough sets a value through a pointer, slice or map - returning a failure message if it cannot be set
+func setThrough(target any, value any) string {
+	tv, vv := reflect.ValueOf(target), reflect.ValueOf(value)
+	if !tv.IsValid() {
+		return "cannot set through nil arg"
+	}
+	switch tv.Kind() {
+	case reflect.Pointer:
+		if tv.IsNil() {
+			return fmt.Sprintf("cannot set through nil %s", tv.Type().String())
+		}
+		et := tv.Type().Elem()
+		if !vv.IsValid() {
+			tv.Elem().Set(reflect.Zero(et))
+		} else if v, ok := convertValue(vv, et); ok {
+			tv.Elem().Set(v)
+		} else if vv.Type() == tv.Type() && !vv.IsNil() {
+			tv.Elem().Set(vv.Elem())
+		} else {
+			return fmt.Sprintf("cannot assign %T to %s", value, et.String())
+		}
+	case reflect.Slice:
+		if !vv.IsValid() || (vv.Kind() != reflect.Slice && vv.Kind() != reflect.Array) || !vv.Type().Elem().AssignableTo(tv.Type().Elem()) {
+			return fmt.Sprintf("cannot copy %T to %s", value, tv.Type().String())
+		} else if vv.Len() > tv.Len() {
+			return fmt.Sprintf("cannot copy %d elements to %s of length %d", vv.Len(), tv.Type().String(), tv.Len())
+		}
+		for i := 0; i < vv.Len(); i++ {
+			tv.Index(i).Set(vv.Index(i))
+		}
+	case reflect.Map:
+		if tv.IsNil() {
+			return fmt.Sprintf("cannot set through nil %s", tv.Type().String())
+		} else if !vv.IsValid() || vv.Kind() != reflect.Map || !vv.Type().Key().AssignableTo(tv.Type().Key()) || !vv.Type().Elem().AssignableTo(tv.Type().Elem()) {
+			return fmt.Sprintf("cannot put %T into %s", value, tv.Type().String())
+		}
+		for it := vv.MapRange(); it.Next(); {
+			tv.SetMapIndex(it.Key(), it.Value())
+		}
+	default:
+		return fmt.Sprintf("cannot set through arg of type %s (must be a pointer, slice or map)", tv.Type().String())
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type decoderMock struct {
+	MockMethods
+}
+
+func (m *decoderMock) Decode(v any) error {
+	return As1[error](m.Called(v))
+}
+
+func (m *decoderMock) Scan(dest ...any) error {
+	return As1[error](m.Called(dest))
+}
+
+func (m *decoderMock) ScanAll(dest ...any) error {
+	return As1[error](m.Called(dest...))
+}
+
+func (m *decoderMock) Fill(buf []byte, props map[string]int) int {
+	return As1[int](m.Called(buf, props))
+}
+
+func (m *decoderMock) Count(n int) int {
+	return As1[int](m.Called(n))
+}
+
+type decoded struct {
+	Name string
+}
+
+func TestCall_SetArg_Pointer(t *testing.T) {
+	m := NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, decoded{Name: "a"}).Return(nil)
+
+	v := &decoded{}
+	require.NoError(t, m.Decode(v))
+	assert.Equal(t, "a", v.Name)
+
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, &decoded{Name: "b"}).Return(nil)
+	require.NoError(t, m.Decode(v))
+	assert.Equal(t, "b", v.Name)
+
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, nil).Return(nil)
+	require.NoError(t, m.Decode(v))
+	assert.Equal(t, "", v.Name)
+}
+
+func TestCall_SetArg_SliceAndMap(t *testing.T) {
+	m := NewMock[decoderMock]()
+	m.OnMethod(m.Fill).SetArg(0, []byte("abc")).SetArg(1, map[string]int{"a": 1}).Return(3)
+
+	buf := make([]byte, 4)
+	props := map[string]int{"b": 2}
+	assert.Equal(t, 3, m.Fill(buf, props))
+	assert.Equal(t, []byte{'a', 'b', 'c', 0}, buf)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, props)
+
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Fill).SetArg(0, []byte("abc")).Return(3)
+	assert.PanicsWithValue(t, "mmock: Fill() SetArg [0]: cannot copy 3 elements to []uint8 of length 2", func() {
+		m.Fill(make([]byte, 2), nil)
+	})
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Fill).SetArg(1, map[string]int{"a": 1}).Return(3)
+	assert.PanicsWithValue(t, "mmock: Fill() SetArg [1]: cannot set through nil map[string]int", func() {
+		m.Fill(nil, nil)
+	})
+}
+
+func TestCall_SetArgs_Variadic(t *testing.T) {
+	m := NewMock[decoderMock]()
+	m.OnMethod(m.Scan).SetArgs("a", 42).Return(nil)
+	var s string
+	var i int64
+	require.NoError(t, m.Scan(&s, &i))
+	assert.Equal(t, "a", s)
+	assert.Equal(t, int64(42), i)
+
+	m.OnMethod(m.ScanAll, mock.Anything, mock.Anything).SetArgs("b", 43).Return(nil)
+	require.NoError(t, m.ScanAll(&s, &i))
+	assert.Equal(t, "b", s)
+	assert.Equal(t, int64(43), i)
+}
+
+func TestCall_SetArg_Failures(t *testing.T) {
+	m := NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, 42).Return(nil)
+	v := &decoded{}
+	assert.PanicsWithValue(t, "mmock: Decode() SetArg [0]: cannot assign int to mmock.decoded", func() {
+		_ = m.Decode(v)
+	})
+	m.AssertMethodCalled(t, m.Decode, v)
+
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, decoded{}).Return(nil)
+	assert.PanicsWithValue(t, "mmock: Decode() SetArg [0]: cannot set through arg of type mmock.decoded (must be a pointer, slice or map)", func() {
+		_ = m.Decode(decoded{})
+	})
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Decode).SetArg(0, decoded{}).Return(nil)
+	assert.PanicsWithValue(t, "mmock: Decode() SetArg [0]: cannot set through nil *mmock.decoded", func() {
+		_ = m.Decode((*decoded)(nil))
+	})
+	m = NewMock[decoderMock]()
+	m.OnMethod(m.Scan).SetArgs("a", "b").Return(nil)
+	var s string
+	assert.PanicsWithValue(t, "mmock: Scan() SetArg [1]: call only has 1 args", func() {
+		_ = m.Scan(&s)
+	})
+}
+
+func TestCall_SetArg_Validates(t *testing.T) {
+	m := NewMock[decoderMock]()
+	assert.PanicsWithValue(t, "mmock: Count() SetArg [0]: arg of type int cannot be set through (must be a pointer, slice or map)", func() {
+		m.OnMethod(m.Count).SetArg(0, 1)
+	})
+	assert.PanicsWithValue(t, "mmock: Decode() SetArg [1]: method only has 1 args", func() {
+		m.OnMethod(m.Decode).SetArg(1, 1)
+	})
+	assert.PanicsWithValue(t, "mmock: Decode() SetArg [-1]: invalid arg index", func() {
+		m.OnMethod(m.Decode).SetArg(-1, 1)
+	})
+}
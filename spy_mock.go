@@ -1,8 +1,7 @@
 package mmock
 
 import (
-	"fmt"
-	"reflect"
+	"testing"
 )
 
 // NewSpyMockOf creates a new spy mock of a specified type and provides the underling wrapped implementation
@@ -11,15 +10,30 @@ import (
 // but have not been expected (by using On or OnMethod) will call this underlying - but you can still assert
 // that the method has been called
 func NewSpyMockOf[T any, I any](wrapped I) *T {
-	r := NewMock[T]()
-	if _, ok := interface{}(r).(I); !ok {
-		i := new(I)
-		panic(fmt.Sprintf("type '%s' does not implement interface '%s'", reflect.TypeOf(r).Elem().String(), reflect.TypeOf(i).Elem().Name()))
+	r, msg := newMockOf[T, I]()
+	if r == nil {
+		panic(msg)
 	}
 	setSpyOf(r, wrapped)
 	return r
 }
 
+// NewSpyMockT creates a new spy mock of a specified type that is bound to a test (or benchmark or fuzz target)
+//
+// same as NewSpyMockOf except that failures are reported to the test rather than panicking, and
+// the mock's expectations are automatically asserted when the test finishes (using tb.Cleanup)
+func NewSpyMockT[T any, I any](tb testing.TB, wrapped I) *T {
+	tb.Helper()
+	r, msg := newMockOf[T, I]()
+	if r == nil {
+		tb.Fatal(msg)
+		return nil
+	}
+	setSpyOf(r, wrapped)
+	bindTest(r, tb)
+	return r
+}
+
 func setSpyOf(mocked any, wrapped any) {
 	if msu, ok := mocked.(mockSetup); ok {
 		msu.SetSpyOf(wrapped)
@@ -33,6 +33,35 @@ func TestNewSpyMockOf_PanicsWithBadMockImpl(t *testing.T) {
 	})
 }
 
+func TestNewSpyMockT(t *testing.T) {
+	underlying := &underlyingFull{
+		calls: map[string]int{},
+	}
+	spy := NewSpyMockT[mockedMy, my](t, underlying)
+	spy.OnMethod(spy.DoSomethingElse).Return(SomeStruct{SomeValue: "a"}, nil)
+	_, err := spy.DoSomething("x", 1)
+	assert.Error(t, err)
+	r, err := spy.DoSomethingElse("x", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+	assert.Equal(t, 1, underlying.calls["DoSomething"])
+	assert.Equal(t, 0, underlying.calls["DoSomethingElse"])
+}
+
+func TestNewSpyMockT_FailsWithBadMockImpl(t *testing.T) {
+	underlying := &underlyingFull{
+		calls: map[string]int{},
+	}
+	type otherMockedImpl struct {
+		MockMethods
+	}
+	ft := &fakeTB{TB: t}
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_ = NewSpyMockT[otherMockedImpl, my](ft, underlying)
+	})
+	assert.Contains(t, ft.errors[0], "does not implement interface 'my'")
+}
+
 func TestSpyMock(t *testing.T) {
 	underlying := &underlyingMin{
 		calls: map[string]int{},
@@ -0,0 +1,74 @@
+package mmock
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+)
+
+// Forbid specifies methods that must never be called on the mock
+//
+// The methods can be specified by func pointer or name - any call to a forbidden method fails immediately (even if
+// the method has been expected using On or OnMethod) and the failure is also reported by AssertExpectations (so that it
+// is not lost if the code under test recovers the panic)
+func (mm *MockMethods) Forbid(methods ...any) {
+	for _, method := range methods {
+		methodName, _ := mm.getMethod(method)
+		mm.lock.Lock()
+		if mm.forbidden == nil {
+			mm.forbidden = map[string]bool{}
+		}
+		mm.forbidden[methodName] = true
+		mm.lock.Unlock()
+	}
+}
+
+// SetStrict sets whether the mock is strict
+//
+// When a mock is strict, any call that has not been expected (using On or OnMethod) fails immediately - even if the
+// mock is nice (see SetNice) or a spy (see SetSpyOf) - and the failure is also reported by AssertExpectations (so
+// that it is not lost if the code under test recovers the panic)
+func (mm *MockMethods) SetStrict(strict bool) {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.strict = strict
+}
+
+// AssertExpectations is the same as Mock.AssertExpectations() (https://pkg.go.dev/github.com/stretchr/testify/mock#Mock.AssertExpectations)
+//
+// Except that unmet expectations are reported with their name and where they were registered - and it also reports any
+// calls to forbidden methods (see Forbid) or unexpected calls on a strict mock (see SetStrict)
+func (mm *MockMethods) AssertExpectations(t mock.TestingT) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	result := mm.assertExpectations(t)
+	mm.lock.Lock()
+	violations := append([]string{}, mm.violations...)
+	mm.lock.Unlock()
+	for _, v := range violations {
+		t.Errorf("%s", v)
+	}
+	mm.reportFaults(t)
+	return result && len(violations) == 0
+}
+
+func (mm *MockMethods) isForbidden(methodName string) bool {
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.forbidden[methodName]
+}
+
+// violation fails because of a call to a forbidden method or an unexpected call on a strict mock
+//
+// if the mock is not bound to a test (see Test), the failure is recorded so that AssertExpectations also reports it
+func (mm *MockMethods) violation(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...) + "\n\tat: " + strings.Join(assert.CallerInfo(), "\n\t\t")
+	mm.lock.Lock()
+	if mm.test == nil {
+		mm.violations = append(mm.violations, msg)
+	}
+	mm.lock.Unlock()
+	mm.fail("%s", msg)
+}
@@ -0,0 +1,81 @@
+package mmock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMockMethods_Forbid(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	m.Forbid(m.DoSomethingElse)
+	m.OnMethod(m.DoSomething).Return(nil, nil)
+	m.OnMethod(m.DoSomethingElse).Maybe().Return(SomeStruct{}, nil)
+
+	_, _ = m.DoSomething("a", 1)
+	assert.False(t, ft.failed)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomethingElse("a", 1)
+	})
+	assert.True(t, ft.failed)
+	assert.Contains(t, ft.errors[0], "mmock: forbidden method called: DoSomethingElse()")
+	assert.Contains(t, ft.errors[0], "strict_test.go")
+	m.AssertMethodNotCalled(t, m.DoSomethingElse)
+}
+
+func TestMockMethods_Forbid_ReportedByAssertExpectations(t *testing.T) {
+	m := NewMock[mockedMy]()
+	m.Forbid("DoSomething")
+	func() {
+		// code under test that recovers panics...
+		defer func() {
+			_ = recover()
+		}()
+		_, _ = m.DoSomething("a", 1)
+	}()
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertExpectations(ft))
+	assert.Equal(t, 1, len(ft.errors))
+	assert.Contains(t, ft.errors[0], "mmock: forbidden method called: DoSomething()")
+}
+
+func TestMockMethods_Forbid_FailsUnknownMethod(t *testing.T) {
+	m := NewMock[mockedMy]()
+	assert.PanicsWithValue(t, "method 'Unknown' does not exist", func() {
+		m.Forbid("Unknown")
+	})
+}
+
+func TestMockMethods_SetStrict(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	m := NewMockT[mockedMy, my](ft)
+	m.SetNice(true)
+	m.SetStrict(true)
+	m.OnMethod(m.DoSomething, "a").Return(nil, nil)
+
+	_, _ = m.DoSomething("a", 1)
+	assert.False(t, ft.failed)
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomething("b", 1)
+	})
+	assert.Contains(t, ft.errors[0], "mmock: strict mock unexpected call: DoSomething()")
+	assert.PanicsWithValue(t, failNowSentinel, func() {
+		_, _ = m.DoSomethingElse("a", 1)
+	})
+	assert.Contains(t, ft.errors[1], "mmock: strict mock unexpected call: DoSomethingElse()")
+}
+
+func TestMockMethods_SetStrict_Spy(t *testing.T) {
+	underlying := &underlyingFull{
+		calls: map[string]int{},
+	}
+	m := NewSpyMockOf[mockedMy, my](underlying)
+	m.SetStrict(true)
+	assert.Panics(t, func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+	assert.Equal(t, 0, underlying.calls["DoSomething"])
+	ft := &fakeTB{TB: t}
+	assert.False(t, m.AssertExpectations(ft))
+	assert.Contains(t, ft.errors[0], "mmock: strict mock unexpected call: DoSomething()")
+}
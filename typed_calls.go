@@ -0,0 +1,37 @@
+package mmock
+
+//go:generate go run ./internal/gentypedcalls -o typed_calls_gen.go
+
+import "github.com/stretchr/testify/mock"
+
+// mockMethodsProvider is implemented by any mock that embeds MockMethods
+type mockMethodsProvider interface {
+	getMockMethods() *MockMethods
+}
+
+func (mm *MockMethods) getMockMethods() *MockMethods {
+	return mm
+}
+
+// typedCall is the base for all typed calls (e.g. Call2R2)
+type typedCall struct {
+	*Call
+}
+
+//go:noinline
+func newTypedCall(m mockMethodsProvider, method any) typedCall {
+	return typedCall{
+		Call: m.getMockMethods().OnMethod(method),
+	}
+}
+
+func (c typedCall) with(arguments ...any) {
+	c.mm.validateArguments(c.Method, c.method, arguments)
+	c.Call.setArguments(arguments)
+}
+
+func (c typedCall) do(fn func(args mock.Arguments) mock.Arguments) {
+	c.Call.Run(func(args mock.Arguments) {
+		c.mm.setReturns(args, fn(args))
+	})
+}
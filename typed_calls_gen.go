@@ -0,0 +1,1455 @@
+// Code generated by gentypedcalls. DO NOT EDIT.
+
+package mmock
+
+import "github.com/stretchr/testify/mock"
+
+// On0R0 sets up an expected call on a method with no args and no results - returning a typed call
+//
+// Example:
+//
+//	mmock.On0R0(myMock, myMock.SomeMethod)
+func On0R0(m mockMethodsProvider, method func()) *Call0R0 {
+	return &Call0R0{typedCall: newTypedCall(m, method)}
+}
+
+// Call0R0 is a typed call for a method with no args and no results
+type Call0R0 struct {
+	typedCall
+}
+
+// Do sets a func to be called with the actual args of the call
+func (c *Call0R0) Do(fn func()) *Call0R0 {
+	c.Call.Run(func(args mock.Arguments) {
+		fn()
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call0R0) Once() *Call0R0 {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call0R0) Times(i int) *Call0R0 {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call0R0) Maybe() *Call0R0 {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call0R0) Named(name string) *Call0R0 {
+	c.Call.Named(name)
+	return c
+}
+
+// On0R1 sets up an expected call on a method with no args and 1 result - returning a typed call
+//
+// Example:
+//
+//	mmock.On0R1(myMock, myMock.SomeMethod).Return(r1)
+func On0R1[R1 any](m mockMethodsProvider, method func() R1) *Call0R1[R1] {
+	return &Call0R1[R1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call0R1 is a typed call for a method with no args and 1 result
+type Call0R1[R1 any] struct {
+	typedCall
+}
+
+// Return sets the values to be returned by the call
+func (c *Call0R1[R1]) Return(r1 R1) *Call0R1[R1] {
+	c.Call.Return(r1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call0R1[R1]) Do(fn func() R1) *Call0R1[R1] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1 := fn()
+		return mock.Arguments{r1}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call0R1[R1]) Once() *Call0R1[R1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call0R1[R1]) Times(i int) *Call0R1[R1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call0R1[R1]) Maybe() *Call0R1[R1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call0R1[R1]) Named(name string) *Call0R1[R1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On0R2 sets up an expected call on a method with no args and 2 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On0R2(myMock, myMock.SomeMethod).Return(r1, r2)
+func On0R2[R1, R2 any](m mockMethodsProvider, method func() (R1, R2)) *Call0R2[R1, R2] {
+	return &Call0R2[R1, R2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call0R2 is a typed call for a method with no args and 2 results
+type Call0R2[R1, R2 any] struct {
+	typedCall
+}
+
+// Return sets the values to be returned by the call
+func (c *Call0R2[R1, R2]) Return(r1 R1, r2 R2) *Call0R2[R1, R2] {
+	c.Call.Return(r1, r2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call0R2[R1, R2]) Do(fn func() (R1, R2)) *Call0R2[R1, R2] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2 := fn()
+		return mock.Arguments{r1, r2}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call0R2[R1, R2]) Once() *Call0R2[R1, R2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call0R2[R1, R2]) Times(i int) *Call0R2[R1, R2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call0R2[R1, R2]) Maybe() *Call0R2[R1, R2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call0R2[R1, R2]) Named(name string) *Call0R2[R1, R2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On0R3 sets up an expected call on a method with no args and 3 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On0R3(myMock, myMock.SomeMethod).Return(r1, r2, r3)
+func On0R3[R1, R2, R3 any](m mockMethodsProvider, method func() (R1, R2, R3)) *Call0R3[R1, R2, R3] {
+	return &Call0R3[R1, R2, R3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call0R3 is a typed call for a method with no args and 3 results
+type Call0R3[R1, R2, R3 any] struct {
+	typedCall
+}
+
+// Return sets the values to be returned by the call
+func (c *Call0R3[R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *Call0R3[R1, R2, R3] {
+	c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call0R3[R1, R2, R3]) Do(fn func() (R1, R2, R3)) *Call0R3[R1, R2, R3] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3 := fn()
+		return mock.Arguments{r1, r2, r3}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call0R3[R1, R2, R3]) Once() *Call0R3[R1, R2, R3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call0R3[R1, R2, R3]) Times(i int) *Call0R3[R1, R2, R3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call0R3[R1, R2, R3]) Maybe() *Call0R3[R1, R2, R3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call0R3[R1, R2, R3]) Named(name string) *Call0R3[R1, R2, R3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On0R4 sets up an expected call on a method with no args and 4 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On0R4(myMock, myMock.SomeMethod).Return(r1, r2, r3, r4)
+func On0R4[R1, R2, R3, R4 any](m mockMethodsProvider, method func() (R1, R2, R3, R4)) *Call0R4[R1, R2, R3, R4] {
+	return &Call0R4[R1, R2, R3, R4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call0R4 is a typed call for a method with no args and 4 results
+type Call0R4[R1, R2, R3, R4 any] struct {
+	typedCall
+}
+
+// Return sets the values to be returned by the call
+func (c *Call0R4[R1, R2, R3, R4]) Return(r1 R1, r2 R2, r3 R3, r4 R4) *Call0R4[R1, R2, R3, R4] {
+	c.Call.Return(r1, r2, r3, r4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call0R4[R1, R2, R3, R4]) Do(fn func() (R1, R2, R3, R4)) *Call0R4[R1, R2, R3, R4] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3, r4 := fn()
+		return mock.Arguments{r1, r2, r3, r4}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call0R4[R1, R2, R3, R4]) Once() *Call0R4[R1, R2, R3, R4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call0R4[R1, R2, R3, R4]) Times(i int) *Call0R4[R1, R2, R3, R4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call0R4[R1, R2, R3, R4]) Maybe() *Call0R4[R1, R2, R3, R4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call0R4[R1, R2, R3, R4]) Named(name string) *Call0R4[R1, R2, R3, R4] {
+	c.Call.Named(name)
+	return c
+}
+
+// On1R0 sets up an expected call on a method with 1 arg and no results - returning a typed call
+//
+// Example:
+//
+//	mmock.On1R0(myMock, myMock.SomeMethod).With(mock.Anything)
+func On1R0[A1 any](m mockMethodsProvider, method func(A1)) *Call1R0[A1] {
+	return &Call1R0[A1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call1R0 is a typed call for a method with 1 arg and no results
+type Call1R0[A1 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call1R0[A1]) With(a1 any) *Call1R0[A1] {
+	c.with(a1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call
+func (c *Call1R0[A1]) Do(fn func(A1)) *Call1R0[A1] {
+	c.Call.Run(func(args mock.Arguments) {
+		fn(As[A1](args, 0))
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call1R0[A1]) Once() *Call1R0[A1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call1R0[A1]) Times(i int) *Call1R0[A1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call1R0[A1]) Maybe() *Call1R0[A1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call1R0[A1]) Named(name string) *Call1R0[A1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On1R1 sets up an expected call on a method with 1 arg and 1 result - returning a typed call
+//
+// Example:
+//
+//	mmock.On1R1(myMock, myMock.SomeMethod).With(mock.Anything).Return(r1)
+func On1R1[A1, R1 any](m mockMethodsProvider, method func(A1) R1) *Call1R1[A1, R1] {
+	return &Call1R1[A1, R1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call1R1 is a typed call for a method with 1 arg and 1 result
+type Call1R1[A1, R1 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call1R1[A1, R1]) With(a1 any) *Call1R1[A1, R1] {
+	c.with(a1)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call1R1[A1, R1]) Return(r1 R1) *Call1R1[A1, R1] {
+	c.Call.Return(r1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call1R1[A1, R1]) Do(fn func(A1) R1) *Call1R1[A1, R1] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1 := fn(As[A1](args, 0))
+		return mock.Arguments{r1}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call1R1[A1, R1]) Once() *Call1R1[A1, R1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call1R1[A1, R1]) Times(i int) *Call1R1[A1, R1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call1R1[A1, R1]) Maybe() *Call1R1[A1, R1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call1R1[A1, R1]) Named(name string) *Call1R1[A1, R1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On1R2 sets up an expected call on a method with 1 arg and 2 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On1R2(myMock, myMock.SomeMethod).With(mock.Anything).Return(r1, r2)
+func On1R2[A1, R1, R2 any](m mockMethodsProvider, method func(A1) (R1, R2)) *Call1R2[A1, R1, R2] {
+	return &Call1R2[A1, R1, R2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call1R2 is a typed call for a method with 1 arg and 2 results
+type Call1R2[A1, R1, R2 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call1R2[A1, R1, R2]) With(a1 any) *Call1R2[A1, R1, R2] {
+	c.with(a1)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call1R2[A1, R1, R2]) Return(r1 R1, r2 R2) *Call1R2[A1, R1, R2] {
+	c.Call.Return(r1, r2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call1R2[A1, R1, R2]) Do(fn func(A1) (R1, R2)) *Call1R2[A1, R1, R2] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2 := fn(As[A1](args, 0))
+		return mock.Arguments{r1, r2}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call1R2[A1, R1, R2]) Once() *Call1R2[A1, R1, R2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call1R2[A1, R1, R2]) Times(i int) *Call1R2[A1, R1, R2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call1R2[A1, R1, R2]) Maybe() *Call1R2[A1, R1, R2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call1R2[A1, R1, R2]) Named(name string) *Call1R2[A1, R1, R2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On1R3 sets up an expected call on a method with 1 arg and 3 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On1R3(myMock, myMock.SomeMethod).With(mock.Anything).Return(r1, r2, r3)
+func On1R3[A1, R1, R2, R3 any](m mockMethodsProvider, method func(A1) (R1, R2, R3)) *Call1R3[A1, R1, R2, R3] {
+	return &Call1R3[A1, R1, R2, R3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call1R3 is a typed call for a method with 1 arg and 3 results
+type Call1R3[A1, R1, R2, R3 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call1R3[A1, R1, R2, R3]) With(a1 any) *Call1R3[A1, R1, R2, R3] {
+	c.with(a1)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call1R3[A1, R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *Call1R3[A1, R1, R2, R3] {
+	c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call1R3[A1, R1, R2, R3]) Do(fn func(A1) (R1, R2, R3)) *Call1R3[A1, R1, R2, R3] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3 := fn(As[A1](args, 0))
+		return mock.Arguments{r1, r2, r3}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call1R3[A1, R1, R2, R3]) Once() *Call1R3[A1, R1, R2, R3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call1R3[A1, R1, R2, R3]) Times(i int) *Call1R3[A1, R1, R2, R3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call1R3[A1, R1, R2, R3]) Maybe() *Call1R3[A1, R1, R2, R3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call1R3[A1, R1, R2, R3]) Named(name string) *Call1R3[A1, R1, R2, R3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On1R4 sets up an expected call on a method with 1 arg and 4 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On1R4(myMock, myMock.SomeMethod).With(mock.Anything).Return(r1, r2, r3, r4)
+func On1R4[A1, R1, R2, R3, R4 any](m mockMethodsProvider, method func(A1) (R1, R2, R3, R4)) *Call1R4[A1, R1, R2, R3, R4] {
+	return &Call1R4[A1, R1, R2, R3, R4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call1R4 is a typed call for a method with 1 arg and 4 results
+type Call1R4[A1, R1, R2, R3, R4 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call1R4[A1, R1, R2, R3, R4]) With(a1 any) *Call1R4[A1, R1, R2, R3, R4] {
+	c.with(a1)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call1R4[A1, R1, R2, R3, R4]) Return(r1 R1, r2 R2, r3 R3, r4 R4) *Call1R4[A1, R1, R2, R3, R4] {
+	c.Call.Return(r1, r2, r3, r4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call1R4[A1, R1, R2, R3, R4]) Do(fn func(A1) (R1, R2, R3, R4)) *Call1R4[A1, R1, R2, R3, R4] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3, r4 := fn(As[A1](args, 0))
+		return mock.Arguments{r1, r2, r3, r4}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call1R4[A1, R1, R2, R3, R4]) Once() *Call1R4[A1, R1, R2, R3, R4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call1R4[A1, R1, R2, R3, R4]) Times(i int) *Call1R4[A1, R1, R2, R3, R4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call1R4[A1, R1, R2, R3, R4]) Maybe() *Call1R4[A1, R1, R2, R3, R4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call1R4[A1, R1, R2, R3, R4]) Named(name string) *Call1R4[A1, R1, R2, R3, R4] {
+	c.Call.Named(name)
+	return c
+}
+
+// On2R0 sets up an expected call on a method with 2 args and no results - returning a typed call
+//
+// Example:
+//
+//	mmock.On2R0(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything)
+func On2R0[A1, A2 any](m mockMethodsProvider, method func(A1, A2)) *Call2R0[A1, A2] {
+	return &Call2R0[A1, A2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call2R0 is a typed call for a method with 2 args and no results
+type Call2R0[A1, A2 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call2R0[A1, A2]) With(a1, a2 any) *Call2R0[A1, A2] {
+	c.with(a1, a2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call
+func (c *Call2R0[A1, A2]) Do(fn func(A1, A2)) *Call2R0[A1, A2] {
+	c.Call.Run(func(args mock.Arguments) {
+		fn(As[A1](args, 0), As[A2](args, 1))
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call2R0[A1, A2]) Once() *Call2R0[A1, A2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call2R0[A1, A2]) Times(i int) *Call2R0[A1, A2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call2R0[A1, A2]) Maybe() *Call2R0[A1, A2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call2R0[A1, A2]) Named(name string) *Call2R0[A1, A2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On2R1 sets up an expected call on a method with 2 args and 1 result - returning a typed call
+//
+// Example:
+//
+//	mmock.On2R1(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything).Return(r1)
+func On2R1[A1, A2, R1 any](m mockMethodsProvider, method func(A1, A2) R1) *Call2R1[A1, A2, R1] {
+	return &Call2R1[A1, A2, R1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call2R1 is a typed call for a method with 2 args and 1 result
+type Call2R1[A1, A2, R1 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call2R1[A1, A2, R1]) With(a1, a2 any) *Call2R1[A1, A2, R1] {
+	c.with(a1, a2)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call2R1[A1, A2, R1]) Return(r1 R1) *Call2R1[A1, A2, R1] {
+	c.Call.Return(r1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call2R1[A1, A2, R1]) Do(fn func(A1, A2) R1) *Call2R1[A1, A2, R1] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1 := fn(As[A1](args, 0), As[A2](args, 1))
+		return mock.Arguments{r1}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call2R1[A1, A2, R1]) Once() *Call2R1[A1, A2, R1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call2R1[A1, A2, R1]) Times(i int) *Call2R1[A1, A2, R1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call2R1[A1, A2, R1]) Maybe() *Call2R1[A1, A2, R1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call2R1[A1, A2, R1]) Named(name string) *Call2R1[A1, A2, R1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On2R2 sets up an expected call on a method with 2 args and 2 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On2R2(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything).Return(r1, r2)
+func On2R2[A1, A2, R1, R2 any](m mockMethodsProvider, method func(A1, A2) (R1, R2)) *Call2R2[A1, A2, R1, R2] {
+	return &Call2R2[A1, A2, R1, R2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call2R2 is a typed call for a method with 2 args and 2 results
+type Call2R2[A1, A2, R1, R2 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call2R2[A1, A2, R1, R2]) With(a1, a2 any) *Call2R2[A1, A2, R1, R2] {
+	c.with(a1, a2)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call2R2[A1, A2, R1, R2]) Return(r1 R1, r2 R2) *Call2R2[A1, A2, R1, R2] {
+	c.Call.Return(r1, r2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call2R2[A1, A2, R1, R2]) Do(fn func(A1, A2) (R1, R2)) *Call2R2[A1, A2, R1, R2] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2 := fn(As[A1](args, 0), As[A2](args, 1))
+		return mock.Arguments{r1, r2}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call2R2[A1, A2, R1, R2]) Once() *Call2R2[A1, A2, R1, R2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call2R2[A1, A2, R1, R2]) Times(i int) *Call2R2[A1, A2, R1, R2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call2R2[A1, A2, R1, R2]) Maybe() *Call2R2[A1, A2, R1, R2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call2R2[A1, A2, R1, R2]) Named(name string) *Call2R2[A1, A2, R1, R2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On2R3 sets up an expected call on a method with 2 args and 3 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On2R3(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything).Return(r1, r2, r3)
+func On2R3[A1, A2, R1, R2, R3 any](m mockMethodsProvider, method func(A1, A2) (R1, R2, R3)) *Call2R3[A1, A2, R1, R2, R3] {
+	return &Call2R3[A1, A2, R1, R2, R3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call2R3 is a typed call for a method with 2 args and 3 results
+type Call2R3[A1, A2, R1, R2, R3 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call2R3[A1, A2, R1, R2, R3]) With(a1, a2 any) *Call2R3[A1, A2, R1, R2, R3] {
+	c.with(a1, a2)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call2R3[A1, A2, R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *Call2R3[A1, A2, R1, R2, R3] {
+	c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call2R3[A1, A2, R1, R2, R3]) Do(fn func(A1, A2) (R1, R2, R3)) *Call2R3[A1, A2, R1, R2, R3] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3 := fn(As[A1](args, 0), As[A2](args, 1))
+		return mock.Arguments{r1, r2, r3}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call2R3[A1, A2, R1, R2, R3]) Once() *Call2R3[A1, A2, R1, R2, R3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call2R3[A1, A2, R1, R2, R3]) Times(i int) *Call2R3[A1, A2, R1, R2, R3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call2R3[A1, A2, R1, R2, R3]) Maybe() *Call2R3[A1, A2, R1, R2, R3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call2R3[A1, A2, R1, R2, R3]) Named(name string) *Call2R3[A1, A2, R1, R2, R3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On2R4 sets up an expected call on a method with 2 args and 4 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On2R4(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything).Return(r1, r2, r3, r4)
+func On2R4[A1, A2, R1, R2, R3, R4 any](m mockMethodsProvider, method func(A1, A2) (R1, R2, R3, R4)) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	return &Call2R4[A1, A2, R1, R2, R3, R4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call2R4 is a typed call for a method with 2 args and 4 results
+type Call2R4[A1, A2, R1, R2, R3, R4 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) With(a1, a2 any) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.with(a1, a2)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Return(r1 R1, r2 R2, r3 R3, r4 R4) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.Call.Return(r1, r2, r3, r4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Do(fn func(A1, A2) (R1, R2, R3, R4)) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3, r4 := fn(As[A1](args, 0), As[A2](args, 1))
+		return mock.Arguments{r1, r2, r3, r4}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Once() *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Times(i int) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Maybe() *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call2R4[A1, A2, R1, R2, R3, R4]) Named(name string) *Call2R4[A1, A2, R1, R2, R3, R4] {
+	c.Call.Named(name)
+	return c
+}
+
+// On3R0 sets up an expected call on a method with 3 args and no results - returning a typed call
+//
+// Example:
+//
+//	mmock.On3R0(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything)
+func On3R0[A1, A2, A3 any](m mockMethodsProvider, method func(A1, A2, A3)) *Call3R0[A1, A2, A3] {
+	return &Call3R0[A1, A2, A3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call3R0 is a typed call for a method with 3 args and no results
+type Call3R0[A1, A2, A3 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call3R0[A1, A2, A3]) With(a1, a2, a3 any) *Call3R0[A1, A2, A3] {
+	c.with(a1, a2, a3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call
+func (c *Call3R0[A1, A2, A3]) Do(fn func(A1, A2, A3)) *Call3R0[A1, A2, A3] {
+	c.Call.Run(func(args mock.Arguments) {
+		fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2))
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call3R0[A1, A2, A3]) Once() *Call3R0[A1, A2, A3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call3R0[A1, A2, A3]) Times(i int) *Call3R0[A1, A2, A3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call3R0[A1, A2, A3]) Maybe() *Call3R0[A1, A2, A3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call3R0[A1, A2, A3]) Named(name string) *Call3R0[A1, A2, A3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On3R1 sets up an expected call on a method with 3 args and 1 result - returning a typed call
+//
+// Example:
+//
+//	mmock.On3R1(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything).Return(r1)
+func On3R1[A1, A2, A3, R1 any](m mockMethodsProvider, method func(A1, A2, A3) R1) *Call3R1[A1, A2, A3, R1] {
+	return &Call3R1[A1, A2, A3, R1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call3R1 is a typed call for a method with 3 args and 1 result
+type Call3R1[A1, A2, A3, R1 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call3R1[A1, A2, A3, R1]) With(a1, a2, a3 any) *Call3R1[A1, A2, A3, R1] {
+	c.with(a1, a2, a3)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call3R1[A1, A2, A3, R1]) Return(r1 R1) *Call3R1[A1, A2, A3, R1] {
+	c.Call.Return(r1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call3R1[A1, A2, A3, R1]) Do(fn func(A1, A2, A3) R1) *Call3R1[A1, A2, A3, R1] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2))
+		return mock.Arguments{r1}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call3R1[A1, A2, A3, R1]) Once() *Call3R1[A1, A2, A3, R1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call3R1[A1, A2, A3, R1]) Times(i int) *Call3R1[A1, A2, A3, R1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call3R1[A1, A2, A3, R1]) Maybe() *Call3R1[A1, A2, A3, R1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call3R1[A1, A2, A3, R1]) Named(name string) *Call3R1[A1, A2, A3, R1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On3R2 sets up an expected call on a method with 3 args and 2 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On3R2(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything).Return(r1, r2)
+func On3R2[A1, A2, A3, R1, R2 any](m mockMethodsProvider, method func(A1, A2, A3) (R1, R2)) *Call3R2[A1, A2, A3, R1, R2] {
+	return &Call3R2[A1, A2, A3, R1, R2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call3R2 is a typed call for a method with 3 args and 2 results
+type Call3R2[A1, A2, A3, R1, R2 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call3R2[A1, A2, A3, R1, R2]) With(a1, a2, a3 any) *Call3R2[A1, A2, A3, R1, R2] {
+	c.with(a1, a2, a3)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call3R2[A1, A2, A3, R1, R2]) Return(r1 R1, r2 R2) *Call3R2[A1, A2, A3, R1, R2] {
+	c.Call.Return(r1, r2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call3R2[A1, A2, A3, R1, R2]) Do(fn func(A1, A2, A3) (R1, R2)) *Call3R2[A1, A2, A3, R1, R2] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2))
+		return mock.Arguments{r1, r2}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call3R2[A1, A2, A3, R1, R2]) Once() *Call3R2[A1, A2, A3, R1, R2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call3R2[A1, A2, A3, R1, R2]) Times(i int) *Call3R2[A1, A2, A3, R1, R2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call3R2[A1, A2, A3, R1, R2]) Maybe() *Call3R2[A1, A2, A3, R1, R2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call3R2[A1, A2, A3, R1, R2]) Named(name string) *Call3R2[A1, A2, A3, R1, R2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On3R3 sets up an expected call on a method with 3 args and 3 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On3R3(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything).Return(r1, r2, r3)
+func On3R3[A1, A2, A3, R1, R2, R3 any](m mockMethodsProvider, method func(A1, A2, A3) (R1, R2, R3)) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	return &Call3R3[A1, A2, A3, R1, R2, R3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call3R3 is a typed call for a method with 3 args and 3 results
+type Call3R3[A1, A2, A3, R1, R2, R3 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) With(a1, a2, a3 any) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.with(a1, a2, a3)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Do(fn func(A1, A2, A3) (R1, R2, R3)) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2))
+		return mock.Arguments{r1, r2, r3}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Once() *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Times(i int) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Maybe() *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call3R3[A1, A2, A3, R1, R2, R3]) Named(name string) *Call3R3[A1, A2, A3, R1, R2, R3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On3R4 sets up an expected call on a method with 3 args and 4 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On3R4(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything).Return(r1, r2, r3, r4)
+func On3R4[A1, A2, A3, R1, R2, R3, R4 any](m mockMethodsProvider, method func(A1, A2, A3) (R1, R2, R3, R4)) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	return &Call3R4[A1, A2, A3, R1, R2, R3, R4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call3R4 is a typed call for a method with 3 args and 4 results
+type Call3R4[A1, A2, A3, R1, R2, R3, R4 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) With(a1, a2, a3 any) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.with(a1, a2, a3)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Return(r1 R1, r2 R2, r3 R3, r4 R4) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.Call.Return(r1, r2, r3, r4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Do(fn func(A1, A2, A3) (R1, R2, R3, R4)) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3, r4 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2))
+		return mock.Arguments{r1, r2, r3, r4}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Once() *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Times(i int) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Maybe() *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call3R4[A1, A2, A3, R1, R2, R3, R4]) Named(name string) *Call3R4[A1, A2, A3, R1, R2, R3, R4] {
+	c.Call.Named(name)
+	return c
+}
+
+// On4R0 sets up an expected call on a method with 4 args and no results - returning a typed call
+//
+// Example:
+//
+//	mmock.On4R0(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+func On4R0[A1, A2, A3, A4 any](m mockMethodsProvider, method func(A1, A2, A3, A4)) *Call4R0[A1, A2, A3, A4] {
+	return &Call4R0[A1, A2, A3, A4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call4R0 is a typed call for a method with 4 args and no results
+type Call4R0[A1, A2, A3, A4 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call4R0[A1, A2, A3, A4]) With(a1, a2, a3, a4 any) *Call4R0[A1, A2, A3, A4] {
+	c.with(a1, a2, a3, a4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call
+func (c *Call4R0[A1, A2, A3, A4]) Do(fn func(A1, A2, A3, A4)) *Call4R0[A1, A2, A3, A4] {
+	c.Call.Run(func(args mock.Arguments) {
+		fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2), As[A4](args, 3))
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call4R0[A1, A2, A3, A4]) Once() *Call4R0[A1, A2, A3, A4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call4R0[A1, A2, A3, A4]) Times(i int) *Call4R0[A1, A2, A3, A4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call4R0[A1, A2, A3, A4]) Maybe() *Call4R0[A1, A2, A3, A4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call4R0[A1, A2, A3, A4]) Named(name string) *Call4R0[A1, A2, A3, A4] {
+	c.Call.Named(name)
+	return c
+}
+
+// On4R1 sets up an expected call on a method with 4 args and 1 result - returning a typed call
+//
+// Example:
+//
+//	mmock.On4R1(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(r1)
+func On4R1[A1, A2, A3, A4, R1 any](m mockMethodsProvider, method func(A1, A2, A3, A4) R1) *Call4R1[A1, A2, A3, A4, R1] {
+	return &Call4R1[A1, A2, A3, A4, R1]{typedCall: newTypedCall(m, method)}
+}
+
+// Call4R1 is a typed call for a method with 4 args and 1 result
+type Call4R1[A1, A2, A3, A4, R1 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call4R1[A1, A2, A3, A4, R1]) With(a1, a2, a3, a4 any) *Call4R1[A1, A2, A3, A4, R1] {
+	c.with(a1, a2, a3, a4)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call4R1[A1, A2, A3, A4, R1]) Return(r1 R1) *Call4R1[A1, A2, A3, A4, R1] {
+	c.Call.Return(r1)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call4R1[A1, A2, A3, A4, R1]) Do(fn func(A1, A2, A3, A4) R1) *Call4R1[A1, A2, A3, A4, R1] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2), As[A4](args, 3))
+		return mock.Arguments{r1}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call4R1[A1, A2, A3, A4, R1]) Once() *Call4R1[A1, A2, A3, A4, R1] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call4R1[A1, A2, A3, A4, R1]) Times(i int) *Call4R1[A1, A2, A3, A4, R1] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call4R1[A1, A2, A3, A4, R1]) Maybe() *Call4R1[A1, A2, A3, A4, R1] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call4R1[A1, A2, A3, A4, R1]) Named(name string) *Call4R1[A1, A2, A3, A4, R1] {
+	c.Call.Named(name)
+	return c
+}
+
+// On4R2 sets up an expected call on a method with 4 args and 2 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On4R2(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(r1, r2)
+func On4R2[A1, A2, A3, A4, R1, R2 any](m mockMethodsProvider, method func(A1, A2, A3, A4) (R1, R2)) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	return &Call4R2[A1, A2, A3, A4, R1, R2]{typedCall: newTypedCall(m, method)}
+}
+
+// Call4R2 is a typed call for a method with 4 args and 2 results
+type Call4R2[A1, A2, A3, A4, R1, R2 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) With(a1, a2, a3, a4 any) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.with(a1, a2, a3, a4)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Return(r1 R1, r2 R2) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.Call.Return(r1, r2)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Do(fn func(A1, A2, A3, A4) (R1, R2)) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2), As[A4](args, 3))
+		return mock.Arguments{r1, r2}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Once() *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Times(i int) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Maybe() *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call4R2[A1, A2, A3, A4, R1, R2]) Named(name string) *Call4R2[A1, A2, A3, A4, R1, R2] {
+	c.Call.Named(name)
+	return c
+}
+
+// On4R3 sets up an expected call on a method with 4 args and 3 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On4R3(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(r1, r2, r3)
+func On4R3[A1, A2, A3, A4, R1, R2, R3 any](m mockMethodsProvider, method func(A1, A2, A3, A4) (R1, R2, R3)) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	return &Call4R3[A1, A2, A3, A4, R1, R2, R3]{typedCall: newTypedCall(m, method)}
+}
+
+// Call4R3 is a typed call for a method with 4 args and 3 results
+type Call4R3[A1, A2, A3, A4, R1, R2, R3 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) With(a1, a2, a3, a4 any) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.with(a1, a2, a3, a4)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Return(r1 R1, r2 R2, r3 R3) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.Call.Return(r1, r2, r3)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Do(fn func(A1, A2, A3, A4) (R1, R2, R3)) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2), As[A4](args, 3))
+		return mock.Arguments{r1, r2, r3}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Once() *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Times(i int) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Maybe() *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call4R3[A1, A2, A3, A4, R1, R2, R3]) Named(name string) *Call4R3[A1, A2, A3, A4, R1, R2, R3] {
+	c.Call.Named(name)
+	return c
+}
+
+// On4R4 sets up an expected call on a method with 4 args and 4 results - returning a typed call
+//
+// Example:
+//
+//	mmock.On4R4(myMock, myMock.SomeMethod).With(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(r1, r2, r3, r4)
+func On4R4[A1, A2, A3, A4, R1, R2, R3, R4 any](m mockMethodsProvider, method func(A1, A2, A3, A4) (R1, R2, R3, R4)) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	return &Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]{typedCall: newTypedCall(m, method)}
+}
+
+// Call4R4 is a typed call for a method with 4 args and 4 results
+type Call4R4[A1, A2, A3, A4, R1, R2, R3, R4 any] struct {
+	typedCall
+}
+
+// With sets the expected args of the call (args can be values or argument matchers - e.g. mock.Anything)
+//
+// The args are checked against the method signature (as they are by MockMethods.OnMethod)
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) With(a1, a2, a3, a4 any) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.with(a1, a2, a3, a4)
+	return c
+}
+
+// Return sets the values to be returned by the call
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Return(r1 R1, r2 R2, r3 R3, r4 R4) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.Call.Return(r1, r2, r3, r4)
+	return c
+}
+
+// Do sets a func to be called with the actual args of the call - the results of which are returned by the call
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Do(fn func(A1, A2, A3, A4) (R1, R2, R3, R4)) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.do(func(args mock.Arguments) mock.Arguments {
+		r1, r2, r3, r4 := fn(As[A1](args, 0), As[A2](args, 1), As[A3](args, 2), As[A4](args, 3))
+		return mock.Arguments{r1, r2, r3, r4}
+	})
+	return c
+}
+
+// Once indicates that the call should only be expected once
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Once() *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.Call.Once()
+	return c
+}
+
+// Times indicates that the call should only be expected the specified number of times
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Times(i int) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.Call.Times(i)
+	return c
+}
+
+// Maybe indicates that the call is optional
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Maybe() *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.Call.Maybe()
+	return c
+}
+
+// Named gives the expected call a name - which is reported in failures and the expectations listing
+func (c *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4]) Named(name string) *Call4R4[A1, A2, A3, A4, R1, R2, R3, R4] {
+	c.Call.Named(name)
+	return c
+}
@@ -0,0 +1,132 @@
+package mmock
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+)
+
+func TestOn2R2(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	On2R2(m, m.DoSomething).With("a", mock.Anything).Return(&SomeStruct{SomeValue: "a"}, nil).Once()
+	On2R2(m, m.DoSomething).Return(nil, errors.New("foo"))
+
+	r, err := m.DoSomething("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", r.SomeValue)
+	r, err = m.DoSomething("a", 1)
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	m.AssertNumberOfMethodCalls(t, m.DoSomething, 2)
+	m.AssertExpectations(t)
+}
+
+func TestOn2R2_Do(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	On2R2(m, m.DoSomethingElse).Do(func(s string, i int) (SomeStruct, error) {
+		return SomeStruct{SomeValue: strings.Repeat(s, i)}, nil
+	})
+
+	r, err := m.DoSomethingElse("a", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "aaa", r.SomeValue)
+	r, err = m.DoSomethingElse("b", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "bb", r.SomeValue)
+	m.AssertMethodCalled(t, m.DoSomethingElse, "b", 2)
+}
+
+func TestOn0R0(t *testing.T) {
+	m := NewMock[typedMock]()
+	called := 0
+	On0R0(m, m.NoArgsNoResults).Do(func() {
+		called++
+	}).Times(2)
+
+	m.NoArgsNoResults()
+	m.NoArgsNoResults()
+	assert.Equal(t, 2, called)
+	m.AssertExpectations(t)
+	assert.Panics(t, func() {
+		m.NoArgsNoResults()
+	})
+}
+
+func TestOn1R0(t *testing.T) {
+	m := NewMock[typedMock]()
+	captured := ""
+	On1R0(m, m.OneArgNoResults).With("a").Do(func(s string) {
+		captured = s
+	})
+	On1R0(m, m.OneArgNoResults).Maybe()
+
+	m.OneArgNoResults("a")
+	assert.Equal(t, "a", captured)
+	m.AssertExpectations(t)
+}
+
+func TestOn0R1(t *testing.T) {
+	m := NewMock[typedMock]()
+	On0R1(m, m.NoArgsOneResult).Return(42).Once()
+	On0R1(m, m.NoArgsOneResult).Do(func() int {
+		return 16
+	})
+
+	assert.Equal(t, 42, m.NoArgsOneResult())
+	assert.Equal(t, 16, m.NoArgsOneResult())
+}
+
+func TestOn4R4(t *testing.T) {
+	m := NewMock[typedMock]()
+	On4R4(m, m.FourArgsFourResults).With("a", 1, mock.Anything, mock.Anything).Return("b", 2, true, nil)
+	On4R4(m, m.FourArgsFourResults).Do(func(s string, i int, b bool, f float64) (string, int, bool, error) {
+		return s + s, i * 2, !b, errors.New("foo")
+	})
+
+	s, i, b, err := m.FourArgsFourResults("a", 1, false, 0)
+	assert.Equal(t, "b", s)
+	assert.Equal(t, 2, i)
+	assert.True(t, b)
+	assert.NoError(t, err)
+	s, i, b, err = m.FourArgsFourResults("c", 3, false, 0)
+	assert.Equal(t, "cc", s)
+	assert.Equal(t, 6, i)
+	assert.True(t, b)
+	assert.Error(t, err)
+}
+
+func TestTypedCall_With_ValidatesArgs(t *testing.T) {
+	m := NewMock[typedMock]()
+	assert.PanicsWithValue(t, "mmock: OneArgNoResults() arg [0]: expected type string but got int", func() {
+		On1R0(m, m.OneArgNoResults).With(1)
+	})
+	assert.PanicsWithValue(t, "mmock: FourArgsFourResults() arg [2]: expected type bool but got nil", func() {
+		On4R4(m, m.FourArgsFourResults).With("a", 1, nil, mock.Anything)
+	})
+	assert.NotPanics(t, func() {
+		On1R0(m, m.OneArgNoResults).With(Captor[string]())
+		On4R4(m, m.FourArgsFourResults).With("a", mock.AnythingOfType("int"), true, 1.5)
+	})
+}
+
+type typedMock struct {
+	MockMethods
+}
+
+func (m *typedMock) NoArgsNoResults() {
+	m.Called()
+}
+
+func (m *typedMock) OneArgNoResults(s string) {
+	m.Called(s)
+}
+
+func (m *typedMock) NoArgsOneResult() int {
+	return As1[int](m.Called())
+}
+
+func (m *typedMock) FourArgsFourResults(s string, i int, b bool, f float64) (string, int, bool, error) {
+	return As4[string, int, bool, error](m.Called(s, i, b, f))
+}
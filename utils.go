@@ -1,11 +1,30 @@
 package mmock
 
-import "github.com/stretchr/testify/mock"
+import (
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"reflect"
+	"runtime"
+	"strings"
+)
 
+// As returns the arg at the specified index as the specified type
+//
+// If the arg is nil, the zero value of the type is returned.  If the arg is not of the specified type, it is
+// converted where this can be done without loss (e.g. an int for an int64 result, or a string for a named string type).  Otherwise, As
+// panics with a message naming the mocked method, the index, the expected type and the actual type
 func As[T any](args mock.Arguments, index int) T {
 	r := args.Get(index)
 	if r != nil {
-		return r.(T)
+		if v, ok := r.(T); ok {
+			return v
+		}
+		tt := reflect.TypeOf((*T)(nil)).Elem()
+		if cv, ok := convertValue(reflect.ValueOf(r), tt); ok {
+			return cv.Interface().(T)
+		}
+		method, label := callerMethod(args)
+		panic(fmt.Sprintf("mmock: %s%s [%d]: expected type %s but got %T", method, label, index, tt.String(), r))
 	}
 	var rn T
 	return rn
@@ -26,3 +45,81 @@ func As3[T1 any, T2 any, T3 any](args mock.Arguments) (T1, T2, T3) {
 func As4[T1 any, T2 any, T3 any, T4 any](args mock.Arguments) (T1, T2, T3, T4) {
 	return As[T1](args, 0), As[T2](args, 1), As[T3](args, 2), As[T4](args, 3)
 }
+
+func As5[T1 any, T2 any, T3 any, T4 any, T5 any](args mock.Arguments) (T1, T2, T3, T4, T5) {
+	return As[T1](args, 0), As[T2](args, 1), As[T3](args, 2), As[T4](args, 3), As[T5](args, 4)
+}
+
+func As6[T1 any, T2 any, T3 any, T4 any, T5 any, T6 any](args mock.Arguments) (T1, T2, T3, T4, T5, T6) {
+	return As[T1](args, 0), As[T2](args, 1), As[T3](args, 2), As[T4](args, 3), As[T5](args, 4), As[T6](args, 5)
+}
+
+func As7[T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any](args mock.Arguments) (T1, T2, T3, T4, T5, T6, T7) {
+	return As[T1](args, 0), As[T2](args, 1), As[T3](args, 2), As[T4](args, 3), As[T5](args, 4), As[T6](args, 5), As[T7](args, 6)
+}
+
+func As8[T1 any, T2 any, T3 any, T4 any, T5 any, T6 any, T7 any, T8 any](args mock.Arguments) (T1, T2, T3, T4, T5, T6, T7, T8) {
+	return As[T1](args, 0), As[T2](args, 1), As[T3](args, 2), As[T4](args, 3), As[T5](args, 4), As[T6](args, 5), As[T7](args, 6), As[T8](args, 7)
+}
+
+// convertValue converts a value to the specified type - but only if the conversion is lossless
+// (i.e. numeric values that fit the type or named types with identical underlying types)
+func convertValue(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	vt := v.Type()
+	if vt.AssignableTo(t) {
+		return v.Convert(t), true
+	} else if isNumeric(vt.Kind()) && isNumeric(t.Kind()) {
+		cv := v.Convert(t)
+		if (isSigned(vt.Kind()) && v.Int() < 0 && isUnsigned(t.Kind())) || (isUnsigned(vt.Kind()) && isSigned(t.Kind()) && cv.Int() < 0) {
+			return v, false
+		}
+		return cv, cv.Convert(vt).Interface() == v.Interface()
+	} else if vt.Kind() == t.Kind() && vt.ConvertibleTo(t) {
+		return v.Convert(t), true
+	}
+	return v, false
+}
+
+func isNumeric(k reflect.Kind) bool {
+	return isSigned(k) || isUnsigned(k) || k == reflect.Float32 || k == reflect.Float64
+}
+
+func isSigned(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+// callerMethod finds the name of the mocked method for use in messages - and whether the args are the args ("arg") or results
+// ("result") of the method
+//
+// If the args are those of an in-progress call (e.g. As used in a Run func), it's the method of the matched call - otherwise
+// the args are the results of the method that called As
+func callerMethod(args mock.Arguments) (string, string) {
+	if cap(args) > 0 {
+		if methodName, ok := callsInProgress.Load(&args[:1][0]); ok {
+			return methodName.(string) + "() ", "arg"
+		}
+	}
+	return callerFrameMethod(), "result"
+}
+
+// callerFrameMethod finds the name of the mocked method that called As (from the stack)
+func callerFrameMethod() string {
+	pcs := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, pkgMmock+".As") {
+			if frame.Function != "" {
+				return parseMethodName(frame.Function) + "() "
+			}
+			return ""
+		}
+		if !more {
+			return ""
+		}
+	}
+}
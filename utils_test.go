@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"reflect"
 	"testing"
 )
 
@@ -52,3 +53,94 @@ func TestAs4(t *testing.T) {
 	assert.Error(t, a2)
 	assert.True(t, a3)
 }
+
+type namedString string
+
+type namedInts []int
+
+func TestAs_Converts(t *testing.T) {
+	args := mock.Arguments{1, "id", []int{1, 2}, 1.0, int64(2), nil, uint8(3)}
+	assert.Equal(t, int64(1), As[int64](args, 0))
+	assert.Equal(t, float64(1), As[float64](args, 0))
+	assert.Equal(t, namedString("id"), As[namedString](args, 1))
+	assert.Equal(t, namedInts{1, 2}, As[namedInts](args, 2))
+	assert.Equal(t, 1, As[int](args, 3))
+	assert.Equal(t, int8(2), As[int8](args, 4))
+	assert.Nil(t, As[*SomeStruct](args, 5))
+	assert.Nil(t, As[namedInts](args, 5))
+	assert.Equal(t, 3, As[int](args, 6))
+}
+
+func TestAs_PanicsWithClearMessage(t *testing.T) {
+	args := mock.Arguments{"a", 1.5, -1, 300}
+	assert.PanicsWithValue(t, "mmock: func1() result [0]: expected type int but got string", func() {
+		_ = As[int](args, 0)
+	})
+	assert.PanicsWithValue(t, "mmock: func2() result [1]: expected type int but got float64", func() {
+		_ = As[int](args, 1)
+	})
+	assert.PanicsWithValue(t, "mmock: func3() result [2]: expected type uint but got int", func() {
+		_ = As[uint](args, 2)
+	})
+	assert.PanicsWithValue(t, "mmock: func4() result [3]: expected type int8 but got int", func() {
+		_, _, _, _ = As4[string, float64, int, int8](args)
+	})
+}
+
+func TestAs_PanicMessageNamesMockedMethod(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.On("DoSomething", "a", 1).Return(SomeStruct{}, nil)
+	assert.PanicsWithValue(t, "mmock: DoSomething() result [0]: expected type *mmock.SomeStruct but got mmock.SomeStruct", func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+}
+
+func TestAs_PanicMessageNamesMatchedMethodArgInRunFunc(t *testing.T) {
+	m := NewMockOf[mockedMy, my]()
+	m.OnMethod(m.DoSomething).Run(func(args mock.Arguments) {
+		_ = As[int](args, 0)
+	}).Return(nil, nil)
+	assert.PanicsWithValue(t, "mmock: DoSomething() arg [0]: expected type int but got string", func() {
+		_, _ = m.DoSomething("a", 1)
+	})
+}
+
+func TestAs5_8(t *testing.T) {
+	args := mock.Arguments{"a", 1, int64(2), 3.0, true, []string{"b"}, errors.New(""), nil}
+	a0, a1, a2, a3, a4 := As5[string, int, int64, float64, bool](args)
+	assert.Equal(t, "a", a0)
+	assert.Equal(t, 1, a1)
+	assert.Equal(t, int64(2), a2)
+	assert.Equal(t, 3.0, a3)
+	assert.True(t, a4)
+	_, _, _, _, _, a5 := As6[string, int, int64, float64, bool, []string](args)
+	assert.Equal(t, []string{"b"}, a5)
+	_, _, _, _, _, _, a6 := As7[string, int, int64, float64, bool, []string, error](args)
+	assert.Error(t, a6)
+	_, _, _, _, _, _, _, a7 := As8[string, int, int64, float64, bool, []string, error, *SomeStruct](args)
+	assert.Nil(t, a7)
+}
+
+func TestConvertValue(t *testing.T) {
+	testCases := []struct {
+		value  any
+		to     any
+		expect bool
+	}{
+		{value: 1, to: int64(0), expect: true},
+		{value: int64(1), to: 0, expect: true},
+		{value: 1, to: uint(0), expect: true},
+		{value: -1, to: uint(0), expect: false},
+		{value: uint64(1 << 63), to: int64(0), expect: false},
+		{value: 256, to: uint8(0), expect: false},
+		{value: 1.5, to: 0, expect: false},
+		{value: 0.1, to: float32(0), expect: false},
+		{value: 65, to: "", expect: false},
+		{value: "a", to: namedString(""), expect: true},
+		{value: SomeStruct{}, to: &SomeStruct{}, expect: false},
+	}
+	for _, tc := range testCases {
+		_, ok := convertValue(reflect.ValueOf(tc.value), reflect.TypeOf(tc.to))
+		assert.Equal(t, tc.expect, ok, "%#v to %T", tc.value, tc.to)
+	}
+}